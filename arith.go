@@ -1,8 +1,6 @@
 package evmmax_arith
 
 import (
-	"encoding/binary"
-	"math/big"
 	"math/bits"
 )
 
@@ -35,48 +33,51 @@ func madd2(a, b, c, d uint64) (uint64, uint64) {
 	return hi, lo
 }
 
+// mulFunc and addOrSubFunc implementations must produce correct results when
+// out aliases x or y.
 type mulFunc func(out, x, y, mod []uint64, modInv uint64)
 type addOrSubFunc func(out, x, y, mod []uint64)
+type sqrFunc func(out, x, mod []uint64, modInv uint64)
 
-func lt(x, y []uint64) bool {
-	for i := len(x); i > 0; i-- {
-		if x[i-1] < y[i-1] {
-			return true
-		}
+// sqrFromMul returns a squaring function which multiplies a value by itself.
+func sqrFromMul(mul mulFunc) sqrFunc {
+	return func(out, x, mod []uint64, modInv uint64) {
+		mul(out, x, x, mod, modInv)
 	}
-	return false
 }
 
-// place a big-endian byte slice to a descending-significance ordered array of limbs
-func placeBEBytesInOutput(out []uint64, b []byte) {
-	// pad the bytes to be the same size as the output
-	padded := make([]byte, len(out)*8)
-	copy(padded[len(out)*8-len(b):], b)
+// lt returns true if x < y.  x and y must have the same number of limbs.
+func lt(x, y []uint64) bool {
+	return ltMask(x, y) != 0
+}
 
-	// place the bytes into the output limbs
-	resultLimbs := len(out)
-	for i := 0; i < resultLimbs; i++ {
-		out[(resultLimbs-1)-i] = binary.BigEndian.Uint64(padded[i*8 : (i+1)*8])
+// ltMask returns all ones if x < y and zero otherwise, without branching on
+// the values of x and y.  x and y must have the same number of limbs.
+func ltMask(x, y []uint64) uint64 {
+	var b uint64
+	for i := range x {
+		_, b = bits.Sub64(x[i], y[i], b)
 	}
+	return -b
 }
 
+// MulModBinary computes z = x * y % modulus for a power of two modulus.  All
+// operands have the limb count of the modulus.
 func MulModBinary(z, x, y, modulus []uint64, modInv uint64) {
-	result := new(big.Int)
-	result = result.Mul(limbsToInt(x), limbsToInt(y))
-	result = result.Mod(result, limbsToInt(modulus))
-	placeBEBytesInOutput(z, result.Bytes())
+	mul, _, _, _ := binaryArith(len(modulus), BackendGenerated)
+	mul(z, x, y, modulus, modInv)
 }
 
+// AddModBinary computes z = x + y % modulus for a power of two modulus.  All
+// operands have the limb count of the modulus.
 func AddModBinary(z, x, y, modulus []uint64) {
-	result := new(big.Int)
-	result = result.Add(limbsToInt(x), limbsToInt(y))
-	result = result.Mod(result, limbsToInt(modulus))
-	placeBEBytesInOutput(z, result.Bytes())
+	_, _, add, _ := binaryArith(len(modulus), BackendGenerated)
+	add(z, x, y, modulus)
 }
 
+// SubModBinary computes z = x - y % modulus for a power of two modulus.  All
+// operands have the limb count of the modulus.
 func SubModBinary(z, x, y, modulus []uint64) {
-	result := new(big.Int)
-	result = result.Sub(limbsToInt(x), limbsToInt(y))
-	result = result.Mod(result, limbsToInt(modulus))
-	placeBEBytesInOutput(z, result.Bytes())
+	_, _, _, sub := binaryArith(len(modulus), BackendGenerated)
+	sub(z, x, y, modulus)
 }
@@ -0,0 +1,278 @@
+package evmmax_arith
+
+import (
+	"math/bits"
+)
+
+// Loop-based (non-unrolled) arithmetic for any limb count up to maxLimbs,
+// used for moduli wider than the generated presets.
+
+const (
+	// maxLimbs is the maximum limb count of a modulus
+	maxLimbs = maxModulusSize / 8
+
+	// karatsubaLimbs is the limb count from which the Barrett and
+	// pseudo-Mersenne multiplications compute the full product with
+	// Karatsuba multiplication.  Montgomery multiplication does not: its
+	// reduction, interleaved in the CIOS loop, stays quadratic and the
+	// product alone does not gain enough to outweigh it.
+	karatsubaLimbs = 32
+	// karatsubaThreshold is the limb count below which Karatsuba
+	// multiplication falls back to schoolbook multiplication
+	karatsubaThreshold = 16
+)
+
+// montMulGeneric computes out = x * y * R**-1 % mod using the CIOS method.
+func montMulGeneric(out, x, y, mod []uint64, modInv uint64) {
+	n := len(mod)
+	var tBuf [maxLimbs + 2]uint64
+	t := tBuf[:n+2]
+	var C, D, m uint64
+
+	for i := 0; i < n; i++ {
+		// t += x[i] * y
+		C = 0
+		for j := 0; j < n; j++ {
+			C, t[j] = madd2(x[i], y[j], t[j], C)
+		}
+		t[n], D = bits.Add64(t[n], C, 0)
+		t[n+1] = D
+
+		// t = (t + m * mod) / W for m = t[0]n'[0] mod W
+		m = t[0] * modInv
+		C = madd0(m, mod[0], t[0])
+		for j := 1; j < n; j++ {
+			C, t[j-1] = madd2(m, mod[j], t[j], C)
+		}
+		t[n-1], C = bits.Add64(t[n], C, 0)
+		t[n] = t[n+1] + C
+	}
+	finalSub(out, t[:n], t[n], mod)
+}
+
+// finalSub sets out = t - mod if t (with the additional top limb hi) is not
+// less than mod, or t otherwise.  t must be less than 2 * mod.  The selection
+// does not branch on the value of t.
+func finalSub(out, t []uint64, hi uint64, mod []uint64) {
+	var resBuf [maxLimbs]uint64
+	res := resBuf[:len(mod)]
+	var b uint64
+	for j := range mod {
+		res[j], b = bits.Sub64(t[j], mod[j], b)
+	}
+	sel := -(b & (hi ^ 1))
+	for j := range mod {
+		out[j] = res[j] ^ ((res[j] ^ t[j]) & sel)
+	}
+}
+
+// schoolbookMul sets z = x * y where len(z) = len(x) + len(y).
+func schoolbookMul(z, x, y []uint64) {
+	for i := range z {
+		z[i] = 0
+	}
+	for i := range x {
+		var C uint64
+		for j := range y {
+			C, z[i+j] = madd2(x[i], y[j], z[i+j], C)
+		}
+		z[i+len(y)] = C
+	}
+}
+
+// karatsubaScratchSize returns the size of the scratch space needed by
+// karatsubaMul for operands of n limbs.
+func karatsubaScratchSize(n int) int {
+	if n < karatsubaThreshold {
+		return 0
+	}
+	l := n - n/2
+	return 4*(l+1) + karatsubaScratchSize(l+1)
+}
+
+// karatsubaMul sets z = x * y where len(x) = len(y) = n and len(z) = 2n,
+// using 'scratch' of karatsubaScratchSize(n) limbs for intermediate values.
+func karatsubaMul(z, x, y, scratch []uint64) {
+	n := len(x)
+	if n < karatsubaThreshold {
+		schoolbookMul(z, x, y)
+		return
+	}
+
+	// x = x1 * W**h + x0, y = y1 * W**h + y0
+	h := n / 2
+	l := n - h
+	x0, x1 := x[:h], x[h:]
+	y0, y1 := y[:h], y[h:]
+
+	// z0 = x0 * y0, z2 = x1 * y1
+	karatsubaMul(z[:2*h], x0, y0, scratch)
+	karatsubaMul(z[2*h:], x1, y1, scratch)
+
+	// z1 = (x0 + x1) * (y0 + y1) - z0 - z2
+	sx := scratch[:l+1]
+	sy := scratch[l+1 : 2*(l+1)]
+	z1 := scratch[2*(l+1) : 4*(l+1)]
+	addUnequal(sx, x1, x0)
+	addUnequal(sy, y1, y0)
+	karatsubaMul(z1, sx, sy, scratch[4*(l+1):])
+	subInPlace(z1, z[:2*h])
+	subInPlace(z1, z[2*h:])
+
+	// z += z1 * W**h
+	var c uint64
+	for i := 0; i < len(z1) && h+i < len(z); i++ {
+		z[h+i], c = bits.Add64(z[h+i], z1[i], c)
+	}
+	for i := h + len(z1); i < len(z); i++ {
+		z[i], c = bits.Add64(z[i], 0, c)
+	}
+}
+
+// addUnequal sets z = x + y for len(x) >= len(y) and len(z) = len(x) + 1.
+func addUnequal(z, x, y []uint64) {
+	var c uint64
+	for i := range x {
+		var yi uint64
+		if i < len(y) {
+			yi = y[i]
+		}
+		z[i], c = bits.Add64(x[i], yi, c)
+	}
+	z[len(x)] = c
+}
+
+// subInPlace sets z = z - y for len(z) >= len(y), where z >= y.
+func subInPlace(z, y []uint64) {
+	var b uint64
+	for i := range y {
+		z[i], b = bits.Sub64(z[i], y[i], b)
+	}
+	for i := len(y); i < len(z); i++ {
+		z[i], b = bits.Sub64(z[i], 0, b)
+	}
+}
+
+// addModGeneric computes out = x + y % mod.  Addition and subtraction do not
+// depend on whether values are in Montgomery or canonical form, so every
+// backend shares them.
+func addModGeneric(out, x, y, mod []uint64) {
+	var tmpBuf [maxLimbs]uint64
+	tmp := tmpBuf[:len(mod)]
+	var c, c1 uint64
+	for i := range mod {
+		tmp[i], c = bits.Add64(x[i], y[i], c)
+	}
+	for i := range mod {
+		out[i], c1 = bits.Sub64(tmp[i], mod[i], c1)
+	}
+	sel := -(c1 & (c ^ 1))
+	for i := range mod {
+		out[i] ^= (out[i] ^ tmp[i]) & sel
+	}
+}
+
+// subModGeneric computes out = x - y % mod.
+func subModGeneric(out, x, y, mod []uint64) {
+	var tmpBuf [maxLimbs]uint64
+	tmp := tmpBuf[:len(mod)]
+	var c, c1 uint64
+	for i := range mod {
+		tmp[i], c = bits.Sub64(x[i], y[i], c)
+	}
+	for i := range mod {
+		out[i], c1 = bits.Add64(tmp[i], mod[i], c1)
+	}
+	sel := c - 1
+	for i := range mod {
+		out[i] ^= (out[i] ^ tmp[i]) & sel
+	}
+}
+
+// mulModBinaryGeneric computes out = x * y % mod where mod is a power of two.
+func mulModBinaryGeneric(out, x, y, mod []uint64, modInv uint64) {
+	n := len(mod)
+	var tBuf [maxLimbs]uint64
+	t := tBuf[:n]
+	// truncated product: partial products above the top limb are never computed
+	for i := 0; i < n; i++ {
+		var C uint64
+		for j := 0; j < n-1-i; j++ {
+			C, t[i+j] = madd2(x[i], y[j], t[i+j], C)
+		}
+		t[n-1] += x[i]*y[n-1-i] + C
+	}
+	maskBinary(out, t, mod)
+}
+
+// addModBinaryGeneric computes out = x + y % mod where mod is a power of two.
+func addModBinaryGeneric(out, x, y, mod []uint64) {
+	var c uint64
+	for i := range mod {
+		out[i], c = bits.Add64(x[i], y[i], c)
+	}
+	maskBinary(out, out, mod)
+}
+
+// subModBinaryGeneric computes out = x - y % mod where mod is a power of two.
+func subModBinaryGeneric(out, x, y, mod []uint64) {
+	var c uint64
+	for i := range mod {
+		out[i], c = bits.Sub64(x[i], y[i], c)
+	}
+	maskBinary(out, out, mod)
+}
+
+// maskBinary sets out = t & (mod - 1) for a power of two modulus.
+func maskBinary(out, t, mod []uint64) {
+	var mask, b uint64
+	for i := range mod {
+		if i == 0 {
+			mask, b = bits.Sub64(mod[0], 1, 0)
+		} else {
+			mask, b = bits.Sub64(mod[i], 0, b)
+		}
+		out[i] = t[i] & mask
+	}
+}
+
+// montgomeryArith returns the Montgomery arithmetic for the odd modulus mod:
+// the generated presets where available, and the generic implementation
+// otherwise or if the reference backend is selected.  The generated presets
+// are the 32-bit limb family where it is enabled, else the fastest
+// multiplication variant applicable to mod.  If mod is a registered
+// well-known modulus, its specialized arithmetic replaces them, except for
+// the multiplication if the amd64 assembly is selected (see
+// BenchmarkFixedModuli).
+func montgomeryArith(mod []uint64, backend Backend) (mulFunc, sqrFunc, addOrSubFunc, addOrSubFunc) {
+	limbs := len(mod)
+	if backend == BackendReference {
+		return montMulGeneric, sqrFromMul(montMulGeneric), addModGeneric, subModGeneric
+	}
+	if limbs <= len(mulmodPreset) {
+		if mul, add, sub := arith32(limbs); mul != nil {
+			return mul, sqrFromMul(mul), add, sub
+		}
+		variant := selectMontVariant(mod)
+		if fixed := lookupFixedModulus(mod); fixed != nil {
+			// the assembly outperforms the specialized Go multiplication
+			mul := fixed.mul
+			if variant == montADX {
+				mul = variant.mulFunc(limbs)
+			}
+			return mul, fixed.sqr, fixed.add, fixed.sub
+		}
+		return variant.mulFunc(limbs), sqrmodPreset[limbs-1], addmodPreset[limbs-1], submodPreset[limbs-1]
+	}
+	return montMulGeneric, sqrFromMul(montMulGeneric), addModGeneric, subModGeneric
+}
+
+// binaryArith returns the arithmetic for power of two moduli of the given
+// limb count.
+func binaryArith(limbs int, backend Backend) (mulFunc, sqrFunc, addOrSubFunc, addOrSubFunc) {
+	if backend != BackendReference && limbs <= len(mulmodBinaryPreset) {
+		mul := mulmodBinaryPreset[limbs-1]
+		return mul, sqrFromMul(mul), addmodBinaryPreset[limbs-1], submodBinaryPreset[limbs-1]
+	}
+	return mulModBinaryGeneric, sqrFromMul(mulModBinaryGeneric), addModBinaryGeneric, subModBinaryGeneric
+}
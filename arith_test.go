@@ -2,6 +2,7 @@ package evmmax_arith
 
 import (
 	cryptorand "crypto/rand"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -26,7 +27,7 @@ const opRepeat = 10
 func testOp(t *testing.T, op string, mod *big.Int) {
 	fieldCtx, err := NewFieldContext(mod.Bytes(), 256)
 	if err != nil {
-		fmt.Printf("failed to instantiate modulus context: %+v\n", err)
+		t.Fatalf("failed to instantiate modulus context: %+v", err)
 	}
 	elemSize := int(math.Ceil(float64(len(mod.Bytes())) / 8.0))
 
@@ -52,6 +53,10 @@ func testOp(t *testing.T, op string, mod *big.Int) {
 			fieldCtx.MulMod(0, 1, 1, 1, 2, 1, 1)
 			expected = new(big.Int).Mul(xInt, yInt)
 			expected.Mod(expected, fieldCtx.modulusInt)
+		case "sqr":
+			fieldCtx.SqrMod(0, 1, 1, 1, 1)
+			expected = new(big.Int).Mul(xInt, xInt)
+			expected.Mod(expected, mod)
 		case "add":
 			fieldCtx.AddMod(0, 1, 1, 1, 2, 1, 1)
 			expected = new(big.Int).Add(xInt, yInt)
@@ -73,6 +78,72 @@ func testOp(t *testing.T, op string, mod *big.Int) {
 	}
 }
 
+// testCanonicalFieldContext checks the operations of a field context of the
+// given backend, which keeps values in canonical form, against math/big.
+func testCanonicalFieldContext(t *testing.T, r *rand.Rand, mod *big.Int, backend Backend) {
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 4, WithBackend(backend))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fieldCtx.Backend() != backend {
+		t.Fatalf("unexpected backend %s", fieldCtx.Backend())
+	}
+	for i := 0; i < 10; i++ {
+		x, y := randBigInt(r, mod), randBigInt(r, mod)
+		storeInt(t, fieldCtx, 0, x)
+		storeInt(t, fieldCtx, 1, y)
+		if res := loadInt(fieldCtx, 0); res.Cmp(x) != 0 {
+			t.Fatalf("store/load round trip: %s != %s", res, x)
+		}
+
+		fieldCtx.MulMod(2, 1, 0, 1, 1, 1, 1)
+		expected := new(big.Int).Mul(x, y)
+		if res := loadInt(fieldCtx, 2); res.Cmp(expected.Mod(expected, mod)) != 0 {
+			t.Fatalf("%s * %s: received %s != expected %s", x, y, res, expected)
+		}
+		fieldCtx.AddMod(2, 1, 0, 1, 1, 1, 1)
+		expected.Add(x, y)
+		if res := loadInt(fieldCtx, 2); res.Cmp(expected.Mod(expected, mod)) != 0 {
+			t.Fatalf("%s + %s: received %s != expected %s", x, y, res, expected)
+		}
+		fieldCtx.SubMod(2, 1, 0, 1, 1, 1, 1)
+		expected.Sub(x, y)
+		if res := loadInt(fieldCtx, 2); res.Cmp(expected.Mod(expected, mod)) != 0 {
+			t.Fatalf("%s - %s: received %s != expected %s", x, y, res, expected)
+		}
+		fieldCtx.ExpMod(2, 0, y.Bytes())
+		expected.Exp(x, y, mod)
+		if res := loadInt(fieldCtx, 2); res.Cmp(expected) != 0 {
+			t.Fatalf("%s**%s: received %s != expected %s", x, y, res, expected)
+		}
+		// the test moduli are not all prime
+		err := fieldCtx.Inverse(2, 0)
+		if expected.ModInverse(x, mod) == nil {
+			if !errors.Is(err, ErrNotInvertible) {
+				t.Fatalf("%s**-1: expected error, got %v", x, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s**-1: unexpected error: %v", x, err)
+		}
+		if res := loadInt(fieldCtx, 2); res.Cmp(expected) != 0 {
+			t.Fatalf("%s**-1: received %s != expected %s", x, res, expected)
+		}
+		if !mod.ProbablyPrime(20) {
+			continue
+		}
+		fieldCtx.MulMod(2, 1, 0, 1, 0, 1, 1)
+		if err := fieldCtx.Sqrt(3, 2); err != nil {
+			t.Fatalf("sqrt(%s**2): unexpected error: %v", x, err)
+		}
+		root := loadInt(fieldCtx, 3)
+		if square := new(big.Int).Mul(root, root); square.Mod(square, mod).Cmp(loadInt(fieldCtx, 2)) != 0 {
+			t.Fatalf("sqrt(%s**2): %s is not a square root", x, root)
+		}
+	}
+}
+
 func randOddModulus(size int) []byte {
 	res := make([]byte, size)
 
@@ -87,18 +158,44 @@ func randOddModulus(size int) []byte {
 	}
 }
 
+// randEvenModulus returns an even modulus of the given size which has an odd
+// factor greater than one.
+func randEvenModulus(size int) []byte {
+	res := randOddModulus(size)
+	res[0] |= 0x80
+	// clear a random number of low bits, at most size*8 - 2
+	k := int(res[len(res)-1]) % (size*8 - 2)
+	mod := new(big.Int).SetBytes(res)
+	mod.Rsh(mod, uint(k+1))
+	mod.SetBit(mod, 0, 1)
+	return mod.Lsh(mod, uint(k+1)).Bytes()
+}
+
 func randBinaryModulus(size int) []byte {
 	modulus := big.NewInt(1)
 	modulus.Lsh(modulus, uint(size*8))
 	return modulus.Bytes()
 }
 
+// largeTestSizes are the modulus sizes in bytes beyond the generated presets
+// which are covered by TestOps.
+var largeTestSizes = []int{96, 97, 104, 128, 129, 192, 256, 257, 384, 448, 511, 512}
+
 func TestOps(t *testing.T) {
+	sizes := make([]int, 0, 95+len(largeTestSizes))
 	for i := 1; i < 96; i++ {
+		sizes = append(sizes, i)
+	}
+	sizes = append(sizes, largeTestSizes...)
+
+	for _, i := range sizes {
 		mod := new(big.Int).SetBytes(randOddModulus(i))
 		t.Run(fmt.Sprintf("mulmod-odd-%dbyte", i), func(t *testing.T) {
 			testOp(t, "mul", mod)
 		})
+		t.Run(fmt.Sprintf("sqrmod-odd-%dbyte", i), func(t *testing.T) {
+			testOp(t, "sqr", mod)
+		})
 		t.Run(fmt.Sprintf("addmod-odd-%dbyte", i), func(t *testing.T) {
 			testOp(t, "add", mod)
 		})
@@ -106,10 +203,31 @@ func TestOps(t *testing.T) {
 			testOp(t, "sub", mod)
 		})
 
+		mod = new(big.Int).SetBytes(randEvenModulus(i))
+		t.Run(fmt.Sprintf("mulmod-even-%dbyte", i), func(t *testing.T) {
+			testOp(t, "mul", mod)
+		})
+		t.Run(fmt.Sprintf("sqrmod-even-%dbyte", i), func(t *testing.T) {
+			testOp(t, "sqr", mod)
+		})
+		t.Run(fmt.Sprintf("addmod-even-%dbyte", i), func(t *testing.T) {
+			testOp(t, "add", mod)
+		})
+		t.Run(fmt.Sprintf("submod-even-%dbyte", i), func(t *testing.T) {
+			testOp(t, "sub", mod)
+		})
+
+		if i >= maxModulusSize {
+			// 2**(8*i) does not fit in the maximum modulus size
+			continue
+		}
 		mod = new(big.Int).SetBytes(randBinaryModulus(i))
 		t.Run(fmt.Sprintf("mulmod-binary-%dbyte", i), func(t *testing.T) {
 			testOp(t, "mul", mod)
 		})
+		t.Run(fmt.Sprintf("sqrmod-binary-%dbyte", i), func(t *testing.T) {
+			testOp(t, "sqr", mod)
+		})
 		t.Run(fmt.Sprintf("addmod-binary-%dbyte", i), func(t *testing.T) {
 			testOp(t, "add", mod)
 		})
@@ -118,3 +236,119 @@ func TestOps(t *testing.T) {
 		})
 	}
 }
+
+// TestBinaryEdgeValues checks power of two moduli against operands equal to
+// mod-1 and zero, including moduli which leave the top limb partially used.
+func TestBinaryEdgeValues(t *testing.T) {
+	for bitLen := 0; bitLen < 96*8; bitLen += 7 {
+		mod := new(big.Int).Lsh(big.NewInt(1), uint(bitLen))
+		fieldCtx, err := NewFieldContext(mod.Bytes(), 3)
+		if err != nil {
+			t.Fatalf("failed to instantiate modulus context: %v", err)
+		}
+		elemSize := fieldCtx.ElemSize()
+
+		max := new(big.Int).Sub(mod, big.NewInt(1))
+		if err := fieldCtx.Store(0, 1, PadBytes(max.Bytes(), uint64(elemSize))); err != nil {
+			t.Fatal(err)
+		}
+		fieldCtx.Store(1, 1, make([]byte, elemSize))
+
+		ops := []struct {
+			name     string
+			op       func(out, outStride, x, xStride, y, yStride, count uint)
+			x, y     uint
+			expected *big.Int
+		}{
+			{"mul", fieldCtx.MulMod, 0, 0, new(big.Int).Mul(max, max)},
+			{"add", fieldCtx.AddMod, 0, 0, new(big.Int).Add(max, max)},
+			{"sub", fieldCtx.SubMod, 1, 0, new(big.Int).Neg(max)},
+			{"sub", fieldCtx.SubMod, 0, 1, max},
+		}
+		for _, op := range ops {
+			op.op(2, 1, op.x, 1, op.y, 1, 1)
+			op.expected.Mod(op.expected, mod)
+
+			resBytes := make([]byte, elemSize)
+			fieldCtx.Load(resBytes, 2, 1)
+			if res := new(big.Int).SetBytes(resBytes); res.Cmp(op.expected) != 0 {
+				t.Fatalf("%s mod 2**%d: received %s != expected %s", op.name, bitLen, res, op.expected)
+			}
+		}
+	}
+}
+
+// TestExportedBinaryOps checks MulModBinary, AddModBinary and SubModBinary
+// against big.Int arithmetic, for widths with and without generated code.
+func TestExportedBinaryOps(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, limbs := range []int{1, 2, 5, 12, 13, 32, maxLimbs} {
+		for _, bitLen := range []int{64*limbs - 1, 64*limbs - 37} {
+			mod := new(big.Int).Lsh(big.NewInt(1), uint(bitLen))
+			modLimbs := bytesToLimbs(PadBytes(mod.Bytes(), uint64(limbs*8)))
+			ops := []struct {
+				name string
+				op   func(z, x, y []uint64)
+				ref  func(z, x, y *big.Int) *big.Int
+			}{
+				{"mul", func(z, x, y []uint64) { MulModBinary(z, x, y, modLimbs, 0) }, (*big.Int).Mul},
+				{"add", func(z, x, y []uint64) { AddModBinary(z, x, y, modLimbs) }, (*big.Int).Add},
+				{"sub", func(z, x, y []uint64) { SubModBinary(z, x, y, modLimbs) }, (*big.Int).Sub},
+			}
+			for _, op := range ops {
+				for i := 0; i < 10; i++ {
+					x, y := randLimbs(r, modLimbs), randLimbs(r, modLimbs)
+					z := make([]uint64, limbs)
+					op.op(z, x, y)
+					expected := op.ref(new(big.Int), limbsToInt(x), limbsToInt(y))
+					expected.Mod(expected, mod)
+					if res := limbsToInt(z); res.Cmp(expected) != 0 {
+						t.Fatalf("%s mod 2**%d: received %s != expected %s", op.name, bitLen, res, expected)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestMontSqrMaxValues checks squaring of the largest reduced values against
+// multiplication for moduli with all limbs set.
+func TestMontSqrMaxValues(t *testing.T) {
+	for limbs := 1; limbs <= 12; limbs++ {
+		mod := MaxModulus(limbs)
+		modInv := negModInverse(mod[0])
+		for _, x := range [][]uint64{MaxModulus(limbs), make([]uint64, limbs)} {
+			// mod - 1 and 1
+			x[0] ^= 1
+			expected := make([]uint64, limbs)
+			res := make([]uint64, limbs)
+			mulmodPreset[limbs-1](expected, x, x, mod, modInv)
+			sqrmodPreset[limbs-1](res, x, mod, modInv)
+			for i := range res {
+				if res[i] != expected[i] {
+					t.Fatalf("%d limbs: squaring %x: received %x != expected %x", limbs, x, res, expected)
+				}
+			}
+		}
+	}
+}
+
+func TestLtMask(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for limbs := 1; limbs <= 4; limbs++ {
+		for i := 0; i < 1000; i++ {
+			x := make([]uint64, limbs)
+			y := make([]uint64, limbs)
+			for j := range x {
+				// draw limbs from a small range so that equal limbs are common
+				x[j] = uint64(r.Intn(3))
+				y[j] = uint64(r.Intn(3))
+			}
+			expected := limbsToInt(x).Cmp(limbsToInt(y)) < 0
+			mask := ltMask(x, y)
+			if (expected && mask != math.MaxUint64) || (!expected && mask != 0) {
+				t.Fatalf("ltMask(%v, %v) = %x", x, y, mask)
+			}
+		}
+	}
+}
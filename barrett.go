@@ -0,0 +1,116 @@
+package evmmax_arith
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// Barrett reduction (Handbook of Applied Cryptography, algorithm 14.42) for
+// any modulus.  The quotient of a product by the modulus is estimated from
+// its high limbs and a precomputed reciprocal, leaving a remainder less than
+// three times the modulus.  Unlike Montgomery arithmetic, values are kept in
+// canonical form, so storing and loading them requires no conversion.
+
+// barrettMulFunc is the type of the generated multiplications with Barrett
+// reduction, where mu is the reciprocal of the modulus computed by newBarrett.
+type barrettMulFunc func(out, x, y, mod, mu []uint64)
+
+// barrett holds the reciprocal of a modulus of n limbs, and buffers for the
+// intermediate values of a multiplication sized to the modulus.
+type barrett struct {
+	limbs int
+	mu    []uint64 // 2**(128n) / mod, n+1 limbs
+
+	z, q, r, r2, s []uint64
+	scratch        []uint64 // Karatsuba scratch space, for wide moduli only
+}
+
+// newBarrett precomputes the reciprocal of the modulus mod of the given limb
+// count, whose top limb must be non-zero.
+func newBarrett(mod *big.Int, limbs int) *barrett {
+	br := &barrett{
+		limbs: limbs,
+		mu:    make([]uint64, limbs+1),
+		z:     make([]uint64, 2*limbs),
+		q:     make([]uint64, 2*limbs+2),
+		r:     make([]uint64, limbs+1),
+		r2:    make([]uint64, limbs+1),
+		s:     make([]uint64, limbs+1),
+	}
+	if limbs >= karatsubaLimbs {
+		// the quotient estimate multiplies operands of n+1 limbs
+		br.scratch = make([]uint64, karatsubaScratchSize(limbs+1))
+	}
+	mu := new(big.Int).Lsh(big.NewInt(1), uint(128*limbs))
+	mu.Div(mu, mod)
+	// only a modulus of one has a reciprocal exceeding n+1 limbs.  Its only
+	// element is zero, whose products are reduced with any reciprocal.
+	if mu.BitLen() <= 64*(limbs+1) {
+		placeInt(br.mu, mu)
+	}
+	return br
+}
+
+// mulMod computes out = x * y % mod.  It does not branch on the values of x
+// and y.
+func (br *barrett) mulMod(out, x, y, mod []uint64, _ uint64) {
+	n := br.limbs
+	z, q, r, r2, s := br.z, br.q, br.r, br.r2, br.s
+
+	// z = x * y, q = (z / W**(n-1)) * mu
+	if br.scratch != nil {
+		karatsubaMul(z, x, y, br.scratch)
+		karatsubaMul(q, z[n-1:], br.mu, br.scratch)
+	} else {
+		schoolbookMul(z, x, y)
+		schoolbookMul(q, z[n-1:], br.mu)
+	}
+
+	// the quotient estimate is q / W**(n+1).  r2 = quotient * mod % W**(n+1)
+	quot := q[n+1:]
+	for i := range r2 {
+		r2[i] = 0
+	}
+	for i := 0; i <= n; i++ {
+		var C uint64
+		for j := 0; j < n && i+j <= n; j++ {
+			C, r2[i+j] = madd2(quot[i], mod[j], r2[i+j], C)
+		}
+		if i == 0 {
+			r2[n] = C
+		}
+	}
+
+	// r = z - quotient * mod, computed modulo W**(n+1), is less than 3 * mod
+	var b uint64
+	for i := range r {
+		r[i], b = bits.Sub64(z[i], r2[i], b)
+	}
+	for k := 0; k < 2; k++ {
+		b = 0
+		for i := 0; i < n; i++ {
+			s[i], b = bits.Sub64(r[i], mod[i], b)
+		}
+		s[n], b = bits.Sub64(r[n], 0, b)
+		// keep r if r - mod borrows
+		sel := b - 1
+		for i := range r {
+			r[i] ^= (r[i] ^ s[i]) & sel
+		}
+	}
+	copy(out, r[:n])
+}
+
+// barrettArith returns the arithmetic using Barrett reduction for the modulus
+// described by br: the generated multiplication where available, and the
+// loop-based one otherwise.
+func barrettArith(br *barrett) (mulFunc, sqrFunc, addOrSubFunc, addOrSubFunc) {
+	if br.limbs > len(mulmodBarrettPreset) {
+		return br.mulMod, sqrFromMul(br.mulMod), addModGeneric, subModGeneric
+	}
+	generated, mu := mulmodBarrettPreset[br.limbs-1], br.mu
+	mul := func(out, x, y, mod []uint64, _ uint64) {
+		generated(out, x, y, mod, mu)
+	}
+	return mul, sqrFromMul(mul), addmodPreset[br.limbs-1], submodPreset[br.limbs-1]
+}
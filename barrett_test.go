@@ -0,0 +1,82 @@
+package evmmax_arith
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// TestBarrettAgainstReference checks the loop-based Barrett multiplication
+// against math/big for odd and even moduli of various widths.
+func TestBarrettAgainstReference(t *testing.T) {
+	for _, limbs := range []int{1, 2, 4, 6, 12, 13, karatsubaLimbs, 33, maxLimbs} {
+		t.Run(fmt.Sprintf("%d-limbs", limbs), func(t *testing.T) {
+			for _, mod := range barrettTestModuli(limbs) {
+				br := newBarrett(limbsToInt(mod), limbs)
+				checkMul(t, br.mulMod, mulModBigInt, [][]uint64{mod})
+			}
+		})
+	}
+}
+
+func TestBarrettFieldContext(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, size := range []int{1, 8, 31, 48, 96, 97, 256} {
+		for _, mod := range []*big.Int{
+			new(big.Int).SetBytes(randOddModulus(size)),
+			new(big.Int).SetBytes(randEvenModulus(size)),
+		} {
+			t.Run(fmt.Sprintf("%d-byte-%x", size, mod.Bit(0)), func(t *testing.T) {
+				testCanonicalFieldContext(t, r, mod, BackendBarrett)
+			})
+		}
+	}
+	for name, p := range sqrtTestPrimes {
+		t.Run(name, func(t *testing.T) {
+			testCanonicalFieldContext(t, r, p, BackendBarrett)
+		})
+	}
+
+}
+
+// BenchmarkBarrett compares Barrett multiplication and the Store/Load round
+// trip of Barrett contexts against the Montgomery arithmetic selected for
+// moduli of the same width.
+func BenchmarkBarrett(b *testing.B) {
+	r := rand.New(rand.NewSource(42))
+	for _, limbs := range []int{1, 2, 4, 6, 8, 12, 32, 64} {
+		mod := differentialModuli(r, limbs)[0]
+		modInt := limbsToInt(mod)
+		b.Run(fmt.Sprintf("mulmod-barrett-%d-bit", limbs*64), func(b *testing.B) {
+			benchmarkMontMul(b, newBarrett(modInt, limbs).mulMod, mod)
+		})
+		b.Run(fmt.Sprintf("mulmod-montgomery-%d-bit", limbs*64), func(b *testing.B) {
+			mul, _, _, _ := montgomeryArith(mod, BackendGenerated)
+			benchmarkMontMul(b, mul, mod)
+		})
+		for _, backend := range []Backend{BackendBarrett, BackendGenerated} {
+			b.Run(fmt.Sprintf("storeload-%s-%d-bit", backend, limbs*64), func(b *testing.B) {
+				benchmarkStoreLoad(b, modInt, backend)
+			})
+		}
+	}
+}
+
+// benchmarkStoreLoad benchmarks storing a value to and loading it from a
+// context of the given backend.
+func benchmarkStoreLoad(b *testing.B, mod *big.Int, backend Backend) {
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 1, WithBackend(backend))
+	if err != nil {
+		b.Fatal(err)
+	}
+	val := PadBytes(randBigInt(rand.New(rand.NewSource(42)), mod).Bytes(), uint64(fieldCtx.ElemSize()))
+	res := make([]byte, fieldCtx.ElemSize())
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := fieldCtx.Store(0, 1, val); err != nil {
+			b.Fatal(err)
+		}
+		fieldCtx.Load(res, 0, 1)
+	}
+}
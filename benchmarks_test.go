@@ -7,6 +7,15 @@ import (
 	"testing"
 )
 
+// montgomeryModulus returns the largest modulus of the given limb count which
+// is not of the pseudo-Mersenne form: clearing the top bit of the lowest limb
+// leaves c = 2**63 + 1, so that NewFieldContext selects Montgomery arithmetic.
+func montgomeryModulus(limbs int) []uint64 {
+	mod := MaxModulus(limbs)
+	mod[0] &^= 1 << 63
+	return mod
+}
+
 func benchmarkOp(b *testing.B, op string, mod *big.Int) {
 	fieldCtx, err := NewFieldContext(mod.Bytes(), 256)
 	if err != nil {
@@ -29,12 +38,69 @@ func benchmarkOp(b *testing.B, op string, mod *big.Int) {
 			fieldCtx.SubMod(outIdxs[i%256], 1, xIdxs[i%256], 1, yIdxs[i%256], 1, 1)
 		case "mul":
 			fieldCtx.MulMod(outIdxs[i%256], 1, xIdxs[i%256], 1, yIdxs[i%256], 1, 1)
+		case "sqr":
+			fieldCtx.SqrMod(outIdxs[i%256], 1, xIdxs[i%256], 1, 1)
 		default:
 			panic("invalid op")
 		}
 	}
 }
 
+func benchmarkExpMod(b *testing.B, mod *big.Int, constTime bool) {
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 2)
+	if err != nil {
+		panic(err)
+	}
+	exp := make([]byte, fieldCtx.ElemSize())
+	rand.Read(exp)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if constTime {
+			fieldCtx.ExpModConstTime(1, 0, exp)
+		} else {
+			fieldCtx.ExpMod(1, 0, exp)
+		}
+	}
+}
+
+func benchmarkInverse(b *testing.B, mod *big.Int) {
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 2)
+	if err != nil {
+		panic(err)
+	}
+	val := make([]byte, fieldCtx.ElemSize())
+	val[len(val)-1] = 2
+	if err := fieldCtx.Store(0, 1, val); err != nil {
+		panic(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := fieldCtx.Inverse(1, 0); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// benchmarkMontMul benchmarks a Montgomery multiplication implementation in
+// isolation on operands reduced by mod.
+func benchmarkMontMul(b *testing.B, mul mulFunc, mod []uint64) {
+	limbs := len(mod)
+	modInv := negModInverse(mod[0])
+	x := make([]uint64, limbs)
+	y := make([]uint64, limbs)
+	for i := 0; i < limbs; i++ {
+		x[i] = rand.Uint64()
+		y[i] = rand.Uint64()
+	}
+	x[limbs-1] %= mod[limbs-1]
+	y[limbs-1] %= mod[limbs-1]
+	out := make([]uint64, limbs)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		mul(out, x, y, mod, modInv)
+	}
+}
+
 func benchmarkSetmod(b *testing.B, mod *big.Int) {
 	for i := 0; i < b.N; i++ {
 		_, err := NewFieldContext(mod.Bytes(), 1)
@@ -46,8 +112,7 @@ func benchmarkSetmod(b *testing.B, mod *big.Int) {
 
 func BenchmarkOps(b *testing.B) {
 	for i := 1; i <= 12; i++ {
-		limbs := MaxModulus(i)
-		mod := limbsToInt(limbs)
+		mod := limbsToInt(montgomeryModulus(i))
 
 		b.Run(fmt.Sprintf("add-odd-%d-bit", i*64), func(b *testing.B) {
 			benchmarkOp(b, "add", mod)
@@ -58,21 +123,69 @@ func BenchmarkOps(b *testing.B) {
 		b.Run(fmt.Sprintf("mul-odd-%d-bit", i*64), func(b *testing.B) {
 			benchmarkOp(b, "mul", mod)
 		})
+		b.Run(fmt.Sprintf("sqr-odd-%d-bit", i*64), func(b *testing.B) {
+			benchmarkOp(b, "sqr", mod)
+		})
 		b.Run(fmt.Sprintf("setmod-odd-%d-bit", i*64), func(b *testing.B) {
 			benchmarkSetmod(b, mod)
 		})
+
+		// largest power of two modulus which fits in i limbs
+		binaryMod := new(big.Int).Lsh(big.NewInt(1), uint(i*64-1))
+		b.Run(fmt.Sprintf("add-binary-%d-bit", i*64), func(b *testing.B) {
+			benchmarkOp(b, "add", binaryMod)
+		})
+		b.Run(fmt.Sprintf("sub-binary-%d-bit", i*64), func(b *testing.B) {
+			benchmarkOp(b, "sub", binaryMod)
+		})
+		b.Run(fmt.Sprintf("mul-binary-%d-bit", i*64), func(b *testing.B) {
+			benchmarkOp(b, "mul", binaryMod)
+		})
 	}
 
-	limbs := MaxModulus(6)
-	mod := limbsToInt(limbs)
+	// widths served by the generic backend
+	for _, i := range []int{16, 32, 48, 64} {
+		mod := limbsToInt(montgomeryModulus(i))
+		binaryMod := new(big.Int).Lsh(big.NewInt(1), uint(i*64-1))
 
-	b.Run(fmt.Sprintf("mul-%d-bit-asm", 384), func(b *testing.B) {
-		benchmarkOp(b, "mul", mod)
-	})
-	b.Run(fmt.Sprintf("add-%d-bit-asm", 384), func(b *testing.B) {
-		benchmarkOp(b, "add", mod)
-	})
-	b.Run(fmt.Sprintf("sub-%d-bit-asm", 384), func(b *testing.B) {
-		benchmarkOp(b, "sub", mod)
-	})
+		b.Run(fmt.Sprintf("add-odd-%d-bit", i*64), func(b *testing.B) {
+			benchmarkOp(b, "add", mod)
+		})
+		b.Run(fmt.Sprintf("mul-odd-%d-bit", i*64), func(b *testing.B) {
+			benchmarkOp(b, "mul", mod)
+		})
+		b.Run(fmt.Sprintf("mul-binary-%d-bit", i*64), func(b *testing.B) {
+			benchmarkOp(b, "mul", binaryMod)
+		})
+		b.Run(fmt.Sprintf("montmul-generic-%d-bit", i*64), func(b *testing.B) {
+			benchmarkMontMul(b, montMulGeneric, MaxModulus(i))
+		})
+	}
+
+	for _, i := range []int{4, 6, 12, 32, 64} {
+		mod := limbsToInt(montgomeryModulus(i))
+		b.Run(fmt.Sprintf("exp-odd-%d-bit", i*64), func(b *testing.B) {
+			benchmarkExpMod(b, mod, false)
+		})
+		b.Run(fmt.Sprintf("exp-consttime-odd-%d-bit", i*64), func(b *testing.B) {
+			benchmarkExpMod(b, mod, true)
+		})
+		b.Run(fmt.Sprintf("inv-odd-%d-bit", i*64), func(b *testing.B) {
+			benchmarkInverse(b, mod)
+		})
+	}
+
+	// Montgomery multiplication variants, on moduli with a spare bit in the
+	// top limb so that every variant is applicable
+	for i := 1; i <= len(mulmodPreset); i++ {
+		mod := MaxModulus(i)
+		mod[i-1] >>= 2
+		for _, v := range montVariants {
+			if mul := v.mulFunc(i); mul != nil {
+				b.Run(fmt.Sprintf("montmul-%s-%d-bit", v, i*64), func(b *testing.B) {
+					benchmarkMontMul(b, mul, mod)
+				})
+			}
+		}
+	}
 }
@@ -0,0 +1,218 @@
+package evmmax_arith
+
+// checkBounds verifies that the offsets [offset, offset+stride, ..., offset+stride*(count - 1)]
+// all lie within the scratch space.
+func (m *FieldContext) checkBounds(operand string, offset, stride, count uint) error {
+	if count == 0 {
+		return nil
+	}
+	n := m.scratchSpaceElemCount
+	// (count - 1) * stride is compared by division to avoid overflow
+	if offset >= n || (stride != 0 && count-1 > (n-1-offset)/stride) {
+		return &BoundsError{
+			Operand:  operand,
+			Offset:   offset,
+			Stride:   stride,
+			Count:    count,
+			NumElems: n,
+		}
+	}
+	return nil
+}
+
+// checkBatchBounds validates the operands of a batch arithmetic operation.
+func (m *FieldContext) checkBatchBounds(out, outStride, x, xStride, y, yStride, count uint) error {
+	if err := m.checkBounds("out", out, outStride, count); err != nil {
+		return err
+	}
+	if err := m.checkBounds("x", x, xStride, count); err != nil {
+		return err
+	}
+	return m.checkBounds("y", y, yStride, count)
+}
+
+// MulModChecked behaves like MulMod but returns an error instead of
+// panicking or corrupting memory if any operand is out of bounds.  The scratch
+// space is not modified if an error is returned.
+func (m *FieldContext) MulModChecked(out, outStride, x, xStride, y, yStride, count uint) error {
+	if err := m.checkBatchBounds(out, outStride, x, xStride, y, yStride, count); err != nil {
+		return err
+	}
+	m.MulMod(out, outStride, x, xStride, y, yStride, count)
+	return nil
+}
+
+// AddModChecked behaves like AddMod but returns an error instead of
+// panicking or corrupting memory if any operand is out of bounds.  The scratch
+// space is not modified if an error is returned.
+func (m *FieldContext) AddModChecked(out, outStride, x, xStride, y, yStride, count uint) error {
+	if err := m.checkBatchBounds(out, outStride, x, xStride, y, yStride, count); err != nil {
+		return err
+	}
+	m.AddMod(out, outStride, x, xStride, y, yStride, count)
+	return nil
+}
+
+// SubModChecked behaves like SubMod but returns an error instead of
+// panicking or corrupting memory if any operand is out of bounds.  The scratch
+// space is not modified if an error is returned.
+func (m *FieldContext) SubModChecked(out, outStride, x, xStride, y, yStride, count uint) error {
+	if err := m.checkBatchBounds(out, outStride, x, xStride, y, yStride, count); err != nil {
+		return err
+	}
+	m.SubMod(out, outStride, x, xStride, y, yStride, count)
+	return nil
+}
+
+// SqrModChecked behaves like SqrMod but returns an error instead of
+// panicking or corrupting memory if any operand is out of bounds.  The scratch
+// space is not modified if an error is returned.
+func (m *FieldContext) SqrModChecked(out, outStride, x, xStride, count uint) error {
+	if err := m.checkBounds("out", out, outStride, count); err != nil {
+		return err
+	}
+	if err := m.checkBounds("x", x, xStride, count); err != nil {
+		return err
+	}
+	m.SqrMod(out, outStride, x, xStride, count)
+	return nil
+}
+
+// checkExpBounds validates the operands of an exponentiation.
+func (m *FieldContext) checkExpBounds(out, base uint) error {
+	if err := m.checkBounds("out", out, 1, 1); err != nil {
+		return err
+	}
+	return m.checkBounds("base", base, 1, 1)
+}
+
+// ExpModChecked behaves like ExpMod but returns an error instead of panicking
+// if any operand is out of bounds.  The scratch space is not modified if an
+// error is returned.
+func (m *FieldContext) ExpModChecked(out, base uint, exp []byte) error {
+	if err := m.checkExpBounds(out, base); err != nil {
+		return err
+	}
+	m.ExpMod(out, base, exp)
+	return nil
+}
+
+// ExpModConstTimeChecked behaves like ExpModConstTime but returns an error
+// instead of panicking if any operand is out of bounds.  The scratch space is
+// not modified if an error is returned.
+func (m *FieldContext) ExpModConstTimeChecked(out, base uint, exp []byte) error {
+	if err := m.checkExpBounds(out, base); err != nil {
+		return err
+	}
+	m.ExpModConstTime(out, base, exp)
+	return nil
+}
+
+// ExpModSlotChecked behaves like ExpModSlot but returns an error instead of
+// panicking if any operand is out of bounds.  The scratch space is not
+// modified if an error is returned.
+func (m *FieldContext) ExpModSlotChecked(out, base, exp uint) error {
+	if err := m.checkExpBounds(out, base); err != nil {
+		return err
+	}
+	if err := m.checkBounds("exp", exp, 1, 1); err != nil {
+		return err
+	}
+	m.ExpModSlot(out, base, exp)
+	return nil
+}
+
+// ExpModSlotConstTimeChecked behaves like ExpModSlotConstTime but returns an
+// error instead of panicking if any operand is out of bounds.  The scratch
+// space is not modified if an error is returned.
+func (m *FieldContext) ExpModSlotConstTimeChecked(out, base, exp uint) error {
+	if err := m.checkExpBounds(out, base); err != nil {
+		return err
+	}
+	if err := m.checkBounds("exp", exp, 1, 1); err != nil {
+		return err
+	}
+	m.ExpModSlotConstTime(out, base, exp)
+	return nil
+}
+
+// InverseChecked behaves like Inverse but returns an error instead of
+// panicking if any operand is out of bounds.  The scratch space is not
+// modified if an error is returned.
+func (m *FieldContext) InverseChecked(out, x uint) error {
+	if err := m.checkBounds("out", out, 1, 1); err != nil {
+		return err
+	}
+	if err := m.checkBounds("x", x, 1, 1); err != nil {
+		return err
+	}
+	return m.Inverse(out, x)
+}
+
+// BatchInverseChecked behaves like BatchInverse but returns an error instead
+// of panicking if any operand is out of bounds.  The scratch space is not
+// modified if an error is returned.
+func (m *FieldContext) BatchInverseChecked(out, outStride, x, xStride, count uint) error {
+	if err := m.checkBounds("out", out, outStride, count); err != nil {
+		return err
+	}
+	if err := m.checkBounds("x", x, xStride, count); err != nil {
+		return err
+	}
+	return m.BatchInverse(out, outStride, x, xStride, count)
+}
+
+// SqrtChecked behaves like Sqrt but returns an error instead of panicking if
+// any operand is out of bounds.  The scratch space is not modified if an
+// error is returned.
+func (m *FieldContext) SqrtChecked(out, x uint) error {
+	if err := m.checkBounds("out", out, 1, 1); err != nil {
+		return err
+	}
+	if err := m.checkBounds("x", x, 1, 1); err != nil {
+		return err
+	}
+	return m.Sqrt(out, x)
+}
+
+// JacobiChecked behaves like Jacobi but returns an error instead of panicking
+// if x is out of bounds.
+func (m *FieldContext) JacobiChecked(x uint) (int, error) {
+	if err := m.checkBounds("x", x, 1, 1); err != nil {
+		return 0, err
+	}
+	return m.Jacobi(x)
+}
+
+// StoreChecked behaves like Store but additionally validates that the
+// destination range is within bounds and that 'from' holds exactly 'count'
+// field elements.
+func (m *FieldContext) StoreChecked(dst, count uint, from []byte) error {
+	if err := m.checkBounds("dst", dst, 1, count); err != nil {
+		return err
+	}
+	if uint(len(from)) != count*m.elemSize {
+		return &BufferLengthError{Expected: int(count * m.elemSize), Actual: len(from)}
+	}
+	return m.Store(dst, count, from)
+}
+
+// LoadChecked behaves like Load but additionally validates that the source
+// range is within bounds and that 'dst' is sized to hold exactly 'count'
+// field elements.
+func (m *FieldContext) LoadChecked(dst []byte, from, count int) error {
+	if from < 0 {
+		return &NegativeOperandError{Operand: "from", Value: from}
+	}
+	if count < 0 {
+		return &NegativeOperandError{Operand: "count", Value: count}
+	}
+	if err := m.checkBounds("from", uint(from), 1, uint(count)); err != nil {
+		return err
+	}
+	if uint(len(dst)) != uint(count)*m.elemSize {
+		return &BufferLengthError{Expected: count * int(m.elemSize), Actual: len(dst)}
+	}
+	m.Load(dst, from, count)
+	return nil
+}
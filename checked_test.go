@@ -0,0 +1,231 @@
+package evmmax_arith
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestCheckedBounds(t *testing.T) {
+	mod := limbsToInt(MaxModulus(4))
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type batchOp func(out, outStride, x, xStride, y, yStride, count uint) error
+	ops := map[string]batchOp{
+		"mul": fieldCtx.MulModChecked,
+		"add": fieldCtx.AddModChecked,
+		"sub": fieldCtx.SubModChecked,
+		"sqr": func(out, outStride, x, xStride, y, yStride, count uint) error {
+			// squaring has no y operand: check it through x
+			if err := fieldCtx.SqrModChecked(out, outStride, y, yStride, count); err != nil {
+				return err
+			}
+			return fieldCtx.SqrModChecked(out, outStride, x, xStride, count)
+		},
+		"inv": func(out, outStride, x, xStride, y, yStride, count uint) error {
+			// inversion has no y operand: check it through x
+			if err := fieldCtx.BatchInverseChecked(out, outStride, y, yStride, count); err != nil {
+				return err
+			}
+			return fieldCtx.BatchInverseChecked(out, outStride, x, xStride, count)
+		},
+	}
+	cases := []struct {
+		name                                          string
+		out, outStride, x, xStride, y, yStride, count uint
+		valid                                         bool
+	}{
+		{"single", 0, 1, 1, 1, 2, 1, 1, true},
+		{"zero count", 100, 1, 100, 1, 100, 1, 0, true},
+		{"full range", 0, 1, 0, 1, 0, 1, 8, true},
+		{"zero stride", 7, 0, 7, 0, 7, 0, 1000, true},
+		{"strided last elem", 1, 3, 0, 2, 0, 1, 3, true},
+		{"out offset", 8, 1, 0, 1, 0, 1, 1, false},
+		{"x offset", 0, 1, 8, 1, 0, 1, 1, false},
+		{"y offset", 0, 1, 0, 1, 8, 1, 1, false},
+		{"count", 0, 1, 0, 1, 0, 1, 9, false},
+		{"stride", 0, 1, 0, 4, 0, 1, 3, false},
+		{"stride overflow", 0, 1, 1, math.MaxUint, 0, 1, 2, false},
+		{"count overflow", 0, 1, 0, 2, 0, 1, math.MaxUint, false},
+	}
+	for name, op := range ops {
+		for _, c := range cases {
+			// make every element invertible, whatever the previous ops left
+			for i := uint(0); i < 8; i++ {
+				storeInt(t, fieldCtx, i, big.NewInt(1))
+			}
+			err := op(c.out, c.outStride, c.x, c.xStride, c.y, c.yStride, c.count)
+			if c.valid && err != nil {
+				t.Fatalf("%s/%s: unexpected error: %v", name, c.name, err)
+			}
+			if !c.valid {
+				var boundsErr *BoundsError
+				if !errors.Is(err, ErrOutOfBounds) || !errors.As(err, &boundsErr) {
+					t.Fatalf("%s/%s: expected bounds error, got %v", name, c.name, err)
+				}
+			}
+		}
+	}
+}
+
+func TestExpModChecked(t *testing.T) {
+	mod := limbsToInt(MaxModulus(4))
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exps := map[string]func(out, base uint, exp []byte) error{
+		"exp":            fieldCtx.ExpModChecked,
+		"exp-const-time": fieldCtx.ExpModConstTimeChecked,
+	}
+	for name, op := range exps {
+		if err := op(1, 0, []byte{3}); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		for _, c := range []struct{ out, base uint }{{2, 0}, {0, 2}, {math.MaxUint, 0}} {
+			var boundsErr *BoundsError
+			if err := op(c.out, c.base, []byte{3}); !errors.As(err, &boundsErr) {
+				t.Fatalf("%s: out %d, base %d: expected bounds error, got %v", name, c.out, c.base, err)
+			}
+		}
+	}
+
+	slotExps := map[string]func(out, base, exp uint) error{
+		"exp-slot":            fieldCtx.ExpModSlotChecked,
+		"exp-slot-const-time": fieldCtx.ExpModSlotConstTimeChecked,
+	}
+	for name, op := range slotExps {
+		if err := op(1, 0, 0); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		for _, c := range []struct{ out, base, exp uint }{{2, 0, 0}, {0, 2, 0}, {0, 0, 2}, {0, 0, math.MaxUint}} {
+			var boundsErr *BoundsError
+			if err := op(c.out, c.base, c.exp); !errors.As(err, &boundsErr) {
+				t.Fatalf("%s: out %d, base %d, exp %d: expected bounds error, got %v", name, c.out, c.base, c.exp, err)
+			}
+		}
+	}
+}
+
+func TestInverseChecked(t *testing.T) {
+	mod := limbsToInt(MaxModulus(4))
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storeInt(t, fieldCtx, 0, big.NewInt(2))
+	if err := fieldCtx.InverseChecked(1, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, c := range []struct{ out, x uint }{{2, 0}, {0, 2}, {math.MaxUint, 0}} {
+		var boundsErr *BoundsError
+		if err := fieldCtx.InverseChecked(c.out, c.x); !errors.As(err, &boundsErr) {
+			t.Fatalf("out %d, x %d: expected bounds error, got %v", c.out, c.x, err)
+		}
+	}
+	// errors of the inversion itself are passed through
+	storeInt(t, fieldCtx, 0, big.NewInt(0))
+	if err := fieldCtx.InverseChecked(1, 0); !errors.Is(err, ErrNotInvertible) {
+		t.Fatalf("expected ErrNotInvertible, got %v", err)
+	}
+}
+
+func TestSqrtChecked(t *testing.T) {
+	// 2**255 - 19
+	mod := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storeInt(t, fieldCtx, 0, big.NewInt(4))
+	if err := fieldCtx.SqrtChecked(1, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if j, err := fieldCtx.JacobiChecked(0); err != nil || j != 1 {
+		t.Fatalf("expected 1, got %d, %v", j, err)
+	}
+	for _, c := range []struct{ out, x uint }{{2, 0}, {0, 2}, {math.MaxUint, 0}} {
+		var boundsErr *BoundsError
+		if err := fieldCtx.SqrtChecked(c.out, c.x); !errors.As(err, &boundsErr) {
+			t.Fatalf("out %d, x %d: expected bounds error, got %v", c.out, c.x, err)
+		}
+	}
+	for _, x := range []uint{2, math.MaxUint} {
+		var boundsErr *BoundsError
+		if _, err := fieldCtx.JacobiChecked(x); !errors.As(err, &boundsErr) {
+			t.Fatalf("x %d: expected bounds error, got %v", x, err)
+		}
+	}
+}
+
+func TestStoreLoadChecked(t *testing.T) {
+	mod := limbsToInt(MaxModulus(2))
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	elemSize := int(fieldCtx.ElemSize())
+
+	if err := fieldCtx.StoreChecked(3, 1, make([]byte, elemSize)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := fieldCtx.StoreChecked(3, 2, make([]byte, 2*elemSize)); !errors.Is(err, ErrOutOfBounds) {
+		t.Fatalf("expected out of bounds error, got %v", err)
+	}
+	if err := fieldCtx.StoreChecked(0, 2, make([]byte, elemSize)); !errors.Is(err, ErrInvalidBufferLength) {
+		t.Fatalf("expected invalid buffer length error, got %v", err)
+	}
+
+	if err := fieldCtx.LoadChecked(make([]byte, 4*elemSize), 0, 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, c := range []struct {
+		from, count int
+		operand     string
+		value       int
+	}{{-1, 1, "from", -1}, {0, -2, "count", -2}} {
+		var negErr *NegativeOperandError
+		err := fieldCtx.LoadChecked(make([]byte, elemSize), c.from, c.count)
+		if !errors.Is(err, ErrOutOfBounds) || !errors.As(err, &negErr) || negErr.Operand != c.operand || negErr.Value != c.value {
+			t.Fatalf("from %d, count %d: expected negative %s error, got %v", c.from, c.count, c.operand, err)
+		}
+	}
+	if err := fieldCtx.LoadChecked(make([]byte, 2*elemSize), 3, 2); !errors.Is(err, ErrOutOfBounds) {
+		t.Fatalf("expected out of bounds error, got %v", err)
+	}
+	if err := fieldCtx.LoadChecked(make([]byte, elemSize+1), 0, 1); !errors.Is(err, ErrInvalidBufferLength) {
+		t.Fatalf("expected invalid buffer length error, got %v", err)
+	}
+}
+
+// TestStoreNotReduced checks that values greater than or equal to the modulus
+// are rejected without modifying the scratch space.
+func TestStoreNotReduced(t *testing.T) {
+	// modulus with a low limb greater than the low limb of the value below
+	mod := new(big.Int).Lsh(big.NewInt(3), 64)
+	mod.Add(mod, big.NewInt(1))
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	elemSize := fieldCtx.ElemSize()
+
+	reduced := PadBytes(big.NewInt(42).Bytes(), uint64(elemSize))
+	notReduced := PadBytes(new(big.Int).Lsh(big.NewInt(5), 64).Bytes(), uint64(elemSize))
+	for _, val := range [][]byte{notReduced, PadBytes(mod.Bytes(), uint64(elemSize))} {
+		if err := fieldCtx.StoreChecked(0, 2, append(append([]byte{}, reduced...), val...)); err == nil {
+			t.Fatalf("expected error storing unreduced value %x", val)
+		}
+	}
+
+	res := make([]byte, elemSize)
+	if err := fieldCtx.LoadChecked(res, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if new(big.Int).SetBytes(res).Sign() != 0 {
+		t.Fatalf("scratch space was modified by a failed store")
+	}
+}
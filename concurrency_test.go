@@ -0,0 +1,116 @@
+package evmmax_arith
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// hammerContext performs random batch operations on its own context, checking
+// every result against math/big.  It reports the first mismatch encountered.
+func hammerContext(seed int64, mod *big.Int, iterations int) error {
+	const numElems = 16
+
+	fieldCtx, err := NewFieldContext(mod.Bytes(), numElems)
+	if err != nil {
+		return err
+	}
+	r := rand.New(rand.NewSource(seed))
+	elemSize := int(fieldCtx.ElemSize())
+
+	// mirror of the scratch space contents in canonical form
+	expected := make([]*big.Int, numElems)
+	for i := 0; i < numElems; i++ {
+		expected[i] = randBigInt(r, mod)
+		if err := fieldCtx.Store(uint(i), 1, PadBytes(expected[i].Bytes(), uint64(elemSize))); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i < iterations; i++ {
+		out, x, y := uint(r.Intn(numElems)), uint(r.Intn(numElems)), uint(r.Intn(numElems))
+		res := new(big.Int)
+		switch r.Intn(3) {
+		case 0:
+			fieldCtx.AddMod(out, 1, x, 1, y, 1, 1)
+			res.Add(expected[x], expected[y])
+		case 1:
+			fieldCtx.SubMod(out, 1, x, 1, y, 1, 1)
+			res.Sub(expected[x], expected[y])
+		case 2:
+			fieldCtx.MulMod(out, 1, x, 1, y, 1, 1)
+			res.Mul(expected[x], expected[y])
+		}
+		expected[out] = res.Mod(res, mod)
+
+		resBytes := make([]byte, elemSize)
+		fieldCtx.Load(resBytes, int(out), 1)
+		if new(big.Int).SetBytes(resBytes).Cmp(expected[out]) != 0 {
+			return fmt.Errorf("iteration %d: mismatch. received %x != expected %x", i, resBytes, expected[out])
+		}
+	}
+	return nil
+}
+
+// TestConcurrentContexts runs operations on many distinct contexts from many
+// goroutines at once.  Run with -race to check that contexts share no state.
+func TestConcurrentContexts(t *testing.T) {
+	const (
+		numGoroutines = 32
+		iterations    = 200
+	)
+
+	var wg sync.WaitGroup
+	errs := make([]error, numGoroutines)
+	for i := 0; i < numGoroutines; i++ {
+		// alternate between odd, binary and even moduli of varying widths
+		var mod *big.Int
+		switch i % 3 {
+		case 0:
+			mod = new(big.Int).SetBytes(randOddModulus(1 + i%96))
+		case 1:
+			mod = new(big.Int).SetBytes(randBinaryModulus(1 + i%96))
+		case 2:
+			mod = new(big.Int).SetBytes(randEvenModulus(1 + i%96))
+		}
+
+		wg.Add(1)
+		go func(i int, mod *big.Int) {
+			defer wg.Done()
+			errs[i] = hammerContext(int64(i), mod, iterations)
+		}(i, mod)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("goroutine %d: %v", i, err)
+		}
+	}
+}
+
+// TestConcurrentContextsSameModulus checks that contexts instantiated with the
+// same modulus do not interfere with each other.
+func TestConcurrentContextsSameModulus(t *testing.T) {
+	const numGoroutines = 16
+
+	mod := limbsToInt(MaxModulus(6))
+	var wg sync.WaitGroup
+	errs := make([]error, numGoroutines)
+	for i := 0; i < numGoroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = hammerContext(int64(i), mod, 500)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("goroutine %d: %v", i, err)
+		}
+	}
+}
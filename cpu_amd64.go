@@ -0,0 +1,32 @@
+//go:build !purego
+
+package evmmax_arith
+
+// cpuid executes the CPUID instruction with the given leaf and subleaf.
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+// supportsADX is true if the CPU supports the BMI2 (MULX) and ADX (ADCX, ADOX)
+// instruction set extensions used by the assembly Montgomery multiplication.
+var supportsADX = detectADX()
+
+func detectADX() bool {
+	maxLeaf, _, _, _ := cpuid(0, 0)
+	if maxLeaf < 7 {
+		return false
+	}
+	_, ebx, _, _ := cpuid(7, 0)
+	const (
+		bmi2 = 1 << 8
+		adx  = 1 << 19
+	)
+	return ebx&bmi2 != 0 && ebx&adx != 0
+}
+
+// mulMontAsm returns the assembly Montgomery multiplication for the given limb
+// count, or nil if there is none or the CPU does not support it.
+func mulMontAsm(limbs int) mulFunc {
+	if !supportsADX || limbs > len(mulmodADXPreset) {
+		return nil
+	}
+	return mulmodADXPreset[limbs-1]
+}
@@ -0,0 +1,21 @@
+//go:build !purego
+
+package evmmax_arith
+
+import (
+	"testing"
+)
+
+func checkGeneratedMulMontADX(t *testing.T, mul mulFunc, limbs int) {
+	if !supportsADX {
+		t.Skip("the CPU does not support BMI2 and ADX")
+	}
+	checkGeneratedMontMul(t, mul, limbs)
+}
+
+func benchmarkGeneratedMulMontADX(b *testing.B, mul mulFunc, limbs int) {
+	if !supportsADX {
+		b.Skip("the CPU does not support BMI2 and ADX")
+	}
+	benchmarkGeneratedMontMul(b, mul, limbs)
+}
@@ -0,0 +1,9 @@
+//go:build !amd64 || purego
+
+package evmmax_arith
+
+// mulMontAsm returns nil: there is no assembly Montgomery multiplication for
+// this platform, or it was disabled with the purego build tag.
+func mulMontAsm(limbs int) mulFunc {
+	return nil
+}
@@ -0,0 +1,131 @@
+package evmmax_arith
+
+import (
+	"math/big"
+)
+
+// crtContext implements arithmetic modulo an even modulus m = 2**k * q (q odd)
+// by performing every operation modulo q and modulo 2**k in separate contexts.
+// Values are split into their residues on Store and recombined on Load.
+type crtContext struct {
+	odd    *FieldContext // Montgomery context for q
+	binary *FieldContext // context for 2**k
+	q      *big.Int
+	qInv   *big.Int // q**-1 % 2**k
+	mask   *big.Int // 2**k - 1
+}
+
+// newCRTFieldContext instantiates a context for an even modulus which is not
+// a power of two.
+func newCRTFieldContext(mod *big.Int, paddedSize, scratchSize int, opts []Option) (*FieldContext, error) {
+	k := mod.TrailingZeroBits()
+	q := new(big.Int).Rsh(mod, k)
+	pow2 := new(big.Int).Lsh(big.NewInt(1), k)
+
+	odd, err := NewFieldContext(q.Bytes(), scratchSize, opts...)
+	if err != nil {
+		return nil, err
+	}
+	binary, err := NewFieldContext(pow2.Bytes(), scratchSize, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	crt := &crtContext{
+		odd:    odd,
+		binary: binary,
+		q:      q,
+		qInv:   new(big.Int).ModInverse(q, pow2),
+		mask:   new(big.Int).Sub(pow2, big.NewInt(1)),
+	}
+	return &FieldContext{
+		Modulus:               bytesToLimbs(PadBytes(mod.Bytes(), uint64(paddedSize))),
+		scratchSpaceElemCount: uint(scratchSize),
+		modulusInt:            mod,
+		elemSize:              uint(paddedSize),
+		crt:                   crt,
+		backend:               odd.backend,
+		constTimeStore:        odd.constTimeStore,
+		AddSubCost:            odd.AddSubCost + binary.AddSubCost,
+		MulCost:               odd.MulCost + binary.MulCost,
+	}, nil
+}
+
+// store splits the big-endian values in 'from' (which have been validated to
+// be reduced) into their residues and stores them in the factor contexts.
+// The residues are computed with math/big, branching on the values: the
+// constant time mode of the factor contexts only covers their own checks.
+func (c *crtContext) store(dst, count uint, from []byte, elemSize uint) error {
+	oddVals := make([]byte, 0, count*c.odd.elemSize)
+	binaryVals := make([]byte, 0, count*c.binary.elemSize)
+	residue := new(big.Int)
+	for i := uint(0); i < count; i++ {
+		val := new(big.Int).SetBytes(from[i*elemSize : (i+1)*elemSize])
+		residue.Mod(val, c.q)
+		oddVals = append(oddVals, PadBytes(residue.Bytes(), uint64(c.odd.elemSize))...)
+		residue.And(val, c.mask)
+		binaryVals = append(binaryVals, PadBytes(residue.Bytes(), uint64(c.binary.elemSize))...)
+	}
+	if err := c.odd.Store(dst, count, oddVals); err != nil {
+		return err
+	}
+	return c.binary.Store(dst, count, binaryVals)
+}
+
+// load recombines the residues of 'count' elements starting at 'from' into
+// big-endian values using Garner's formula:
+// x = a + q * ((b - a) * q**-1 % 2**k) for a = x % q, b = x % 2**k
+func (c *crtContext) load(dst []byte, from, count int, elemSize uint) {
+	oddVals := make([]byte, uint(count)*c.odd.elemSize)
+	binaryVals := make([]byte, uint(count)*c.binary.elemSize)
+	c.odd.Load(oddVals, from, count)
+	c.binary.Load(binaryVals, from, count)
+
+	for i := uint(0); i < uint(count); i++ {
+		a := new(big.Int).SetBytes(oddVals[i*c.odd.elemSize : (i+1)*c.odd.elemSize])
+		b := new(big.Int).SetBytes(binaryVals[i*c.binary.elemSize : (i+1)*c.binary.elemSize])
+		h := b.Sub(b, a)
+		h.Mul(h, c.qInv)
+		h.And(h, c.mask)
+		h.Mul(h, c.q)
+		h.Add(h, a)
+		copy(dst[i*elemSize:(i+1)*elemSize], PadBytes(h.Bytes(), uint64(elemSize)))
+	}
+}
+
+// inverse inverts the element at offset x in both factor contexts, modifying
+// neither if either residue is not invertible.
+func (c *crtContext) inverse(out, x uint) error {
+	oddRes := make([]uint64, len(c.odd.Modulus))
+	binaryRes := make([]uint64, len(c.binary.Modulus))
+	oddOk := c.odd.invert(oddRes, c.odd.elem(x))
+	binaryOk := c.binary.invert(binaryRes, c.binary.elem(x))
+	if !oddOk || !binaryOk {
+		return ErrNotInvertible
+	}
+	copy(c.odd.elem(out), oddRes)
+	copy(c.binary.elem(out), binaryRes)
+	return nil
+}
+
+// batchInverse performs a batch inversion in both factor contexts, modifying
+// neither if any element is not invertible.
+func (c *crtContext) batchInverse(out, outStride, x, xStride, count uint) error {
+	// residues modulo 2**k are invertible iff they are odd: check them up front
+	// so that a failure modulo q is the only one which can occur.
+	for i := uint(0); i < count; i++ {
+		if c.binary.elem(x + i*xStride)[0]&1 == 0 {
+			return ErrNotInvertible
+		}
+	}
+	if err := c.odd.BatchInverse(out, outStride, x, xStride, count); err != nil {
+		return err
+	}
+	return c.binary.BatchInverse(out, outStride, x, xStride, count)
+}
+
+// elem returns the limbs of the element at offset idx.
+func (m *FieldContext) elem(idx uint) []uint64 {
+	elemSize := uint(len(m.Modulus))
+	return m.scratchSpace[idx*elemSize : (idx+1)*elemSize]
+}
@@ -0,0 +1,243 @@
+//go:build dudect
+
+package evmmax_arith
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+)
+
+// Timing leakage tests in the style of dudect (Reparaz, Balasch, Verbauwhede:
+// "Dude, is my code constant time?").  Each target is timed on two classes of
+// inputs, a fixed operand and random operands, chosen at random for every
+// measurement.  Welch's t-test is applied to the two timing distributions; a
+// |t| above dudectThreshold is strong evidence that the timing depends on the
+// operands.
+//
+// Run with:
+//
+//	go test -tags dudect -run Dudect -v
+//
+// Timing measurements are sensitive to machine load and frequency scaling.
+
+var (
+	dudectMeasurements = flag.Int("dudect.measurements", 100000, "number of timing measurements per target")
+	dudectFail         = flag.Bool("dudect.fail", false, "fail targets which exceed the leakage threshold")
+)
+
+const (
+	dudectThreshold = 10
+	// calls of the target per measurement, to lift the measured duration well
+	// above the timer resolution
+	dudectInnerRepeat = 32
+	// upper percentiles of measurements discarded as outliers
+	dudectCropPercentile = 0.9
+)
+
+// dudectPoolSize is the number of operand sets generated for each class
+const dudectPoolSize = 1024
+
+// dudectTarget is a function under test.  Operands for both classes (0: fixed,
+// 1: random) are generated before measuring, so that both classes do the same
+// work outside of the timed section.
+type dudectTarget struct {
+	// fill sets the operands of the given pool entry for a class
+	fill func(r *rand.Rand, class, entry int)
+	// prepare is called untimed before each measurement
+	prepare func(class, entry int)
+	run     func(class, entry int)
+}
+
+// welch accumulates the running mean and variance of two classes
+type welch struct {
+	n, mean, m2 [2]float64
+}
+
+func (w *welch) push(class int, x float64) {
+	w.n[class]++
+	delta := x - w.mean[class]
+	w.mean[class] += delta / w.n[class]
+	w.m2[class] += delta * (x - w.mean[class])
+}
+
+func (w *welch) t() float64 {
+	v0 := w.m2[0] / (w.n[0] - 1)
+	v1 := w.m2[1] / (w.n[1] - 1)
+	return (w.mean[0] - w.mean[1]) / math.Sqrt(v0/w.n[0]+v1/w.n[1])
+}
+
+// measure returns the largest |t| statistic over the raw measurements and the
+// measurements with outliers cropped.
+func (d *dudectTarget) measure(r *rand.Rand, count int) float64 {
+	classes := make([]int, count)
+	entries := make([]int, count)
+	for i := range classes {
+		classes[i] = r.Intn(2)
+		entries[i] = r.Intn(dudectPoolSize)
+	}
+
+	durations := make([]float64, count)
+	for i := range durations {
+		class, entry := classes[i], entries[i]
+		if d.prepare != nil {
+			d.prepare(class, entry)
+		}
+		start := time.Now()
+		for j := 0; j < dudectInnerRepeat; j++ {
+			d.run(class, entry)
+		}
+		durations[i] = float64(time.Since(start))
+	}
+
+	sorted := append([]float64{}, durations...)
+	sort.Float64s(sorted)
+	crop := sorted[int(float64(len(sorted)-1)*dudectCropPercentile)]
+
+	var raw, cropped welch
+	for i, d := range durations {
+		raw.push(classes[i], d)
+		if d <= crop {
+			cropped.push(classes[i], d)
+		}
+	}
+	return math.Max(math.Abs(raw.t()), math.Abs(cropped.t()))
+}
+
+func runDudect(t *testing.T, name string, target *dudectTarget) {
+	t.Run(name, func(t *testing.T) {
+		r := rand.New(rand.NewSource(42))
+		for class := 0; class < 2; class++ {
+			for entry := 0; entry < dudectPoolSize; entry++ {
+				target.fill(r, class, entry)
+			}
+		}
+		// warm up caches and the branch predictor
+		target.measure(r, 1000)
+		tStat := target.measure(r, *dudectMeasurements)
+		leaky := tStat > dudectThreshold
+		t.Logf("%-24s |t| = %8.2f leakage: %v", name, tStat, leaky)
+		if leaky && *dudectFail {
+			t.Fail()
+		}
+	})
+}
+
+// fillOperand sets x to mod-1 for the fixed class, which exercises the final
+// subtraction, or to a random value less than mod otherwise.
+func fillOperand(r *rand.Rand, x, mod []uint64, class int) {
+	if class == 0 {
+		copy(x, mod)
+		x[0]--
+		return
+	}
+	copy(x, randLimbs(r, mod))
+}
+
+// newOperandPool allocates a pool of operands for each class
+func newOperandPool(limbs int) [2][][]uint64 {
+	var pool [2][][]uint64
+	for class := range pool {
+		pool[class] = make([][]uint64, dudectPoolSize)
+		for i := range pool[class] {
+			pool[class][i] = make([]uint64, limbs)
+		}
+	}
+	return pool
+}
+
+func TestDudectGenerated(t *testing.T) {
+	for limbs := 1; limbs <= len(mulmodPreset); limbs++ {
+		mod := MaxModulus(limbs)
+		modInv := negModInverse(mod[0])
+		xs, ys := newOperandPool(limbs), newOperandPool(limbs)
+		out := make([]uint64, limbs)
+		fill := func(r *rand.Rand, class, entry int) {
+			fillOperand(r, xs[class][entry], mod, class)
+			fillOperand(r, ys[class][entry], mod, class)
+		}
+		mul, _, add, sub := montgomeryArith(mod, BackendGenerated)
+
+		runDudect(t, fmt.Sprintf("mulmont-%d-bit", limbs*64), &dudectTarget{fill: fill, run: func(class, entry int) {
+			mul(out, xs[class][entry], ys[class][entry], mod, modInv)
+		}})
+		runDudect(t, fmt.Sprintf("addmod-%d-bit", limbs*64), &dudectTarget{fill: fill, run: func(class, entry int) {
+			add(out, xs[class][entry], ys[class][entry], mod)
+		}})
+		runDudect(t, fmt.Sprintf("submod-%d-bit", limbs*64), &dudectTarget{fill: fill, run: func(class, entry int) {
+			sub(out, xs[class][entry], ys[class][entry], mod)
+		}})
+	}
+}
+
+// dudectBackends are the backends of the contexts timed by
+// TestDudectFieldContext, each with a modulus which the backend applies to
+var dudectBackends = []struct {
+	backend Backend
+	modulus func(limbs int) []uint64
+}{
+	// 2**k - 2**63 - 1 is not of the pseudo-Mersenne form beyond one limb,
+	// so the context uses Montgomery arithmetic
+	{BackendGenerated, func(limbs int) []uint64 {
+		mod := MaxModulus(limbs)
+		mod[0] &^= 1 << 63
+		return mod
+	}},
+	{BackendPseudoMersenne, MaxModulus},
+	{BackendBarrett, MaxModulus},
+}
+
+func TestDudectFieldContext(t *testing.T) {
+	for _, b := range dudectBackends {
+		for _, limbs := range []int{1, 4, 6, 12, 32} {
+			testDudectFieldContext(t, b.backend, b.modulus(limbs))
+		}
+	}
+}
+
+func testDudectFieldContext(t *testing.T, backend Backend, mod []uint64) {
+	const batch = 8
+	limbs := len(mod)
+	fieldCtx, err := NewFieldContext(limbsToBytes(mod), 2*batch, WithConstantTimeStore(), WithBackend(backend))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fieldCtx.Backend() != backend {
+		t.Fatalf("%d limbs: expected backend %s, got %s", limbs, backend, fieldCtx.Backend())
+	}
+	elemSize := int(fieldCtx.ElemSize())
+	var vals [2][][]byte
+	for class := range vals {
+		vals[class] = make([][]byte, dudectPoolSize)
+	}
+	val := make([]uint64, limbs)
+	fill := func(r *rand.Rand, class, entry int) {
+		vals[class][entry] = make([]byte, 0, batch*elemSize)
+		for i := 0; i < batch; i++ {
+			fillOperand(r, val, mod, class)
+			vals[class][entry] = append(vals[class][entry], limbsToBytes(val)...)
+		}
+	}
+	prepare := func(class, entry int) {
+		if err := fieldCtx.Store(0, batch, vals[class][entry]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	runDudect(t, fmt.Sprintf("ctx-%s-mulmod-%d-bit", backend, limbs*64), &dudectTarget{fill, prepare, func(int, int) {
+		fieldCtx.MulMod(batch, 1, 0, 1, 0, 1, batch)
+	}})
+	runDudect(t, fmt.Sprintf("ctx-%s-addmod-%d-bit", backend, limbs*64), &dudectTarget{fill, prepare, func(int, int) {
+		fieldCtx.AddMod(batch, 1, 0, 1, 0, 1, batch)
+	}})
+	runDudect(t, fmt.Sprintf("ctx-%s-submod-%d-bit", backend, limbs*64), &dudectTarget{fill, prepare, func(int, int) {
+		fieldCtx.SubMod(batch, 1, 0, 1, 0, 1, batch)
+	}})
+	runDudect(t, fmt.Sprintf("ctx-%s-store-%d-bit", backend, limbs*64), &dudectTarget{fill, nil, func(class, entry int) {
+		fieldCtx.Store(batch, batch, vals[class][entry])
+	}})
+}
@@ -0,0 +1,107 @@
+package evmmax_arith
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by NewFieldContext for invalid moduli or scratch space sizes.
+var (
+	ErrModulusTooLarge      = errors.New("modulus cannot be greater than 4096 bits")
+	ErrModulusEmpty         = errors.New("modulus must be non-empty")
+	ErrModulusLeadingZero   = errors.New("most significant byte of modulus must not be zero")
+	ErrScratchSpaceEmpty    = errors.New("scratch space must have non-zero size")
+	ErrScratchSpaceTooLarge = errors.New("scratch space can allocate a maximum of 256 field elements")
+	ErrUnknownBackend       = errors.New("unknown arithmetic backend")
+	ErrBackendUnsupported   = errors.New("arithmetic backend not supported for modulus")
+)
+
+var (
+	// ErrValueNotReduced is returned by Store when a value is not less than
+	// the modulus.  The returned error is a *ValueNotReducedError.
+	ErrValueNotReduced = errors.New("value must be less than modulus")
+
+	// ErrOutOfBounds is returned by the checked operations when an operand
+	// references field elements outside of the allocated scratch space.
+	ErrOutOfBounds = errors.New("field element offset out of bounds")
+
+	// ErrInvalidBufferLength is returned by the checked operations when the
+	// length of a byte slice does not match the number of field elements
+	// being stored or loaded.
+	ErrInvalidBufferLength = errors.New("invalid buffer length")
+
+	// ErrNotInvertible is returned when inverting an element which shares a
+	// factor with the modulus.
+	ErrNotInvertible = errors.New("element is not invertible")
+
+	// ErrNoSquareRoot is returned when computing the square root of an
+	// element which is not a quadratic residue.
+	ErrNoSquareRoot = errors.New("element is not a quadratic residue")
+
+	// ErrUnsupportedModulus is returned by operations which are not defined
+	// for the modulus of the context.
+	ErrUnsupportedModulus = errors.New("operation not supported for modulus")
+)
+
+// ValueNotReducedError describes a value passed to Store which is not less
+// than the modulus.
+type ValueNotReducedError struct {
+	Index uint // index of the offending element within the stored values
+}
+
+func (e *ValueNotReducedError) Error() string {
+	return fmt.Sprintf("element %d: %s", e.Index, ErrValueNotReduced)
+}
+
+func (e *ValueNotReducedError) Unwrap() error {
+	return ErrValueNotReduced
+}
+
+// BoundsError describes an operand of a checked operation which references
+// field elements outside of the scratch space.
+type BoundsError struct {
+	Operand  string // name of the offending operand (e.g. "out", "x", "y")
+	Offset   uint
+	Stride   uint
+	Count    uint
+	NumElems uint // number of field elements allocated in the context
+}
+
+func (e *BoundsError) Error() string {
+	return fmt.Sprintf("%s: offset %d with stride %d and count %d exceeds scratch space of %d elements",
+		e.Operand, e.Offset, e.Stride, e.Count, e.NumElems)
+}
+
+func (e *BoundsError) Unwrap() error {
+	return ErrOutOfBounds
+}
+
+// NegativeOperandError describes a negative offset or count passed to a
+// checked operation taking signed operands.
+type NegativeOperandError struct {
+	Operand string // name of the offending operand (e.g. "from", "count")
+	Value   int
+}
+
+func (e *NegativeOperandError) Error() string {
+	return fmt.Sprintf("%s: negative value %d", e.Operand, e.Value)
+}
+
+func (e *NegativeOperandError) Unwrap() error {
+	return ErrOutOfBounds
+}
+
+// BufferLengthError describes a byte slice passed to a checked operation whose
+// length does not match the expected length.
+type BufferLengthError struct {
+	Expected int
+	Actual   int
+}
+
+func (e *BufferLengthError) Error() string {
+	return fmt.Sprintf("%s: expected %d bytes, got %d", ErrInvalidBufferLength, e.Expected, e.Actual)
+}
+
+func (e *BufferLengthError) Unwrap() error {
+	return ErrInvalidBufferLength
+}
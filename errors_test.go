@@ -0,0 +1,88 @@
+package evmmax_arith
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewFieldContextErrors(t *testing.T) {
+	cases := []struct {
+		name        string
+		modBytes    []byte
+		scratchSize int
+		expected    error
+	}{
+		{"too large", append([]byte{1}, make([]byte, maxModulusSize)...), 1, ErrModulusTooLarge},
+		{"empty", nil, 1, ErrModulusEmpty},
+		{"leading zero", []byte{0, 7}, 1, ErrModulusLeadingZero},
+		{"no scratch space", []byte{7}, 0, ErrScratchSpaceEmpty},
+		{"negative scratch space", []byte{7}, -1, ErrScratchSpaceEmpty},
+		{"scratch space too large", []byte{7}, 257, ErrScratchSpaceTooLarge},
+	}
+	for _, c := range cases {
+		if _, err := NewFieldContext(c.modBytes, c.scratchSize); !errors.Is(err, c.expected) {
+			t.Fatalf("%s: expected %v, got %v", c.name, c.expected, err)
+		}
+	}
+}
+
+func TestValueNotReducedError(t *testing.T) {
+	fieldCtx, err := NewFieldContext([]byte{7}, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	elemSize := fieldCtx.ElemSize()
+	vals := make([]byte, 3*elemSize)
+	vals[elemSize-1] = 6
+	vals[3*elemSize-1] = 7
+
+	err = fieldCtx.Store(0, 3, vals)
+	var notReduced *ValueNotReducedError
+	if !errors.Is(err, ErrValueNotReduced) || !errors.As(err, &notReduced) {
+		t.Fatalf("expected value not reduced error, got %v", err)
+	}
+	if notReduced.Index != 2 {
+		t.Fatalf("expected error for element 2, got element %d", notReduced.Index)
+	}
+}
+
+func TestValueNotReducedErrorConstantTime(t *testing.T) {
+	for _, mod := range [][]byte{{7}, {8}, {12}} {
+		fieldCtx, err := NewFieldContext(mod, 4, WithConstantTimeStore())
+		if err != nil {
+			t.Fatal(err)
+		}
+		elemSize := fieldCtx.ElemSize()
+		// elements 1 and 3 are not reduced
+		vals := make([]byte, 4*elemSize)
+		vals[elemSize-1] = 1
+		vals[2*elemSize-1] = mod[0]
+		vals[4*elemSize-1] = 0xff
+
+		err = fieldCtx.Store(0, 4, vals)
+		var notReduced *ValueNotReducedError
+		if !errors.As(err, &notReduced) {
+			t.Fatalf("expected value not reduced error, got %v", err)
+		}
+		if notReduced.Index != 1 {
+			t.Fatalf("expected error for element 1, got element %d", notReduced.Index)
+		}
+
+		// nothing is stored if any element is not reduced
+		res := make([]byte, elemSize)
+		fieldCtx.Load(res, 0, 1)
+		if res[elemSize-1] != 0 {
+			t.Fatalf("element 0 was stored despite error")
+		}
+
+		vals[2*elemSize-1] = mod[0] - 1
+		vals[4*elemSize-1] = 0
+		if err := fieldCtx.Store(0, 4, vals); err != nil {
+			t.Fatal(err)
+		}
+		fieldCtx.Load(res, 1, 1)
+		if res[elemSize-1] != mod[0]-1 {
+			t.Fatalf("expected %d, got %d", mod[0]-1, res[elemSize-1])
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package evmmax_arith
+
+// expWindowBits is the window size of the fixed-window exponentiation
+const expWindowBits = 4
+
+// ExpMod sets the element at offset 'out' to the element at offset 'base'
+// raised to the power of the big-endian exponent 'exp'.
+//
+// Execution time depends on the value of the exponent: use ExpModConstTime for
+// secret exponents.  it is not validated that inputs are within bounds: use
+// ExpModChecked for untrusted inputs.
+func (m *FieldContext) ExpMod(out, base uint, exp []byte) {
+	m.expMod(out, base, exp, false)
+}
+
+// ExpModConstTime behaves like ExpMod, but performs the same sequence of
+// operations and memory accesses for every exponent of a given length.  Use
+// ExpModConstTimeChecked for untrusted inputs.
+func (m *FieldContext) ExpModConstTime(out, base uint, exp []byte) {
+	m.expMod(out, base, exp, true)
+}
+
+// ExpModSlot sets the element at offset 'out' to the element at offset 'base'
+// raised to the power of the (canonical) value of the element at offset 'exp'.
+//
+// Execution time depends on the value of the exponent: use
+// ExpModSlotConstTime for secret exponents.  it is not validated that inputs
+// are within bounds: use ExpModSlotChecked for untrusted inputs.
+func (m *FieldContext) ExpModSlot(out, base, exp uint) {
+	m.expMod(out, base, m.canonicalBytes(exp), false)
+}
+
+// ExpModSlotConstTime behaves like ExpModSlot, but performs the same sequence of
+// operations and memory accesses for every exponent.  Use
+// ExpModSlotConstTimeChecked for untrusted inputs.
+func (m *FieldContext) ExpModSlotConstTime(out, base, exp uint) {
+	m.expMod(out, base, m.canonicalBytes(exp), true)
+}
+
+// canonicalBytes returns the big-endian canonical value of the element at
+// offset idx.
+func (m *FieldContext) canonicalBytes(idx uint) []byte {
+	res := make([]byte, m.elemSize)
+	m.Load(res, int(idx), 1)
+	return res
+}
+
+// expMod sets the element at offset 'out' to the element at offset 'base'
+// raised to the power of 'exp'.
+func (m *FieldContext) expMod(out, base uint, exp []byte, constTime bool) {
+	if m.crt != nil {
+		m.crt.odd.expMod(out, base, exp, constTime)
+		m.crt.binary.expMod(out, base, exp, constTime)
+		return
+	}
+	elemSize := uint(len(m.Modulus))
+	res := make([]uint64, elemSize)
+	m.exp(res, m.scratchSpace[base*elemSize:(base+1)*elemSize], exp, constTime)
+	copy(m.scratchSpace[out*elemSize:(out+1)*elemSize], res)
+}
+
+// exp sets out = base**exp, both in the internal representation, using a
+// fixed-window exponentiation scanning the exponent from the most significant
+// window.  The accumulator starts from the table entry of the top window.  In
+// constant-time mode leading zero windows are not skipped, a multiplication is
+// performed for every other window and table entries are selected by scanning
+// the whole table.
+func (m *FieldContext) exp(out, base []uint64, exp []byte, constTime bool) {
+	elemSize := uint(len(m.Modulus))
+	const tableSize = 1 << expWindowBits
+
+	// table[i] = base**i
+	table := make([]uint64, tableSize*elemSize)
+	copy(table[:elemSize], m.oneRepr)
+	copy(table[elemSize:2*elemSize], base)
+	for i := uint(2); i < tableSize; i++ {
+		m.mulMod(table[i*elemSize:(i+1)*elemSize],
+			table[(i-1)*elemSize:i*elemSize],
+			table[elemSize:2*elemSize],
+			m.Modulus,
+			m.modInv)
+	}
+
+	acc := make([]uint64, elemSize)
+	copy(acc, m.oneRepr)
+	entry := make([]uint64, elemSize)
+	started := false
+	for _, b := range exp {
+		for _, window := range [2]uint64{uint64(b >> 4), uint64(b & 0xf)} {
+			if !started {
+				if !constTime && window == 0 {
+					continue
+				}
+				// start from the table entry of the top window rather than
+				// squaring one
+				if constTime {
+					ctLookup(acc, table, window)
+				} else {
+					copy(acc, table[window*uint64(elemSize):(window+1)*uint64(elemSize)])
+				}
+				started = true
+				continue
+			}
+
+			for i := 0; i < expWindowBits; i++ {
+				m.sqrMod(acc, acc, m.Modulus, m.modInv)
+			}
+			if constTime {
+				ctLookup(entry, table, window)
+			} else if window != 0 {
+				copy(entry, table[window*uint64(elemSize):(window+1)*uint64(elemSize)])
+			} else {
+				continue
+			}
+			m.mulMod(acc, acc, entry, m.Modulus, m.modInv)
+		}
+	}
+	copy(out, acc)
+}
+
+// ctLookup copies the entry at index idx of a table of len(out)-sized entries
+// into out, reading every entry of the table.
+func ctLookup(out, table []uint64, idx uint64) {
+	for i := range out {
+		out[i] = 0
+	}
+	for j := 0; j < len(table)/len(out); j++ {
+		mask := ctEqMask(uint64(j), idx)
+		for i := range out {
+			out[i] |= table[j*len(out)+i] & mask
+		}
+	}
+}
+
+// ctEqMask returns all ones if x == y and zero otherwise, without branching.
+func ctEqMask(x, y uint64) uint64 {
+	d := x ^ y
+	return ((d | -d) >> 63) - 1
+}
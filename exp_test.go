@@ -0,0 +1,81 @@
+package evmmax_arith
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func testExpMod(t *testing.T, mod *big.Int, r *rand.Rand) {
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	elemSize := fieldCtx.ElemSize()
+
+	exps := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), big.NewInt(16), randBigInt(r, mod)}
+	randExp := make([]byte, 1+r.Intn(100))
+	r.Read(randExp)
+	exps = append(exps, new(big.Int).SetBytes(randExp))
+
+	for _, exp := range exps {
+		base := randBigInt(r, mod)
+		expected := new(big.Int).Exp(base, exp, mod)
+		if err := fieldCtx.Store(0, 1, PadBytes(base.Bytes(), uint64(elemSize))); err != nil {
+			t.Fatal(err)
+		}
+
+		check := func(name string) {
+			resBytes := make([]byte, elemSize)
+			fieldCtx.Load(resBytes, 1, 1)
+			if res := new(big.Int).SetBytes(resBytes); res.Cmp(expected) != 0 {
+				t.Fatalf("%s: %s**%s %% %s: received %s != expected %s", name, base, exp, mod, res, expected)
+			}
+		}
+
+		// leading zero bytes must not affect the result
+		fieldCtx.ExpMod(1, 0, append([]byte{0, 0}, exp.Bytes()...))
+		check("ExpMod")
+		fieldCtx.ExpModConstTime(1, 0, exp.Bytes())
+		check("ExpModConstTime")
+
+		if exp.Cmp(mod) < 0 {
+			if err := fieldCtx.Store(2, 1, PadBytes(exp.Bytes(), uint64(elemSize))); err != nil {
+				t.Fatal(err)
+			}
+			fieldCtx.ExpModSlot(1, 0, 2)
+			check("ExpModSlot")
+			fieldCtx.ExpModSlotConstTime(1, 0, 2)
+			check("ExpModSlotConstTime")
+		}
+	}
+
+	// the output may overlap the base
+	base := randBigInt(r, mod)
+	fieldCtx.Store(0, 1, PadBytes(base.Bytes(), uint64(elemSize)))
+	fieldCtx.ExpMod(0, 0, []byte{3})
+	resBytes := make([]byte, elemSize)
+	fieldCtx.Load(resBytes, 0, 1)
+	if res, expected := new(big.Int).SetBytes(resBytes), new(big.Int).Exp(base, big.NewInt(3), mod); res.Cmp(expected) != 0 {
+		t.Fatalf("in-place: received %s != expected %s", res, expected)
+	}
+}
+
+func TestExpMod(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 1; i <= 96; i += 5 {
+		mod := new(big.Int).SetBytes(randOddModulus(i))
+		t.Run(fmt.Sprintf("odd-%dbyte", i), func(t *testing.T) {
+			testExpMod(t, mod, r)
+		})
+		mod = new(big.Int).SetBytes(randBinaryModulus(i - 1))
+		t.Run(fmt.Sprintf("binary-%dbyte", i), func(t *testing.T) {
+			testExpMod(t, mod, r)
+		})
+		mod = new(big.Int).SetBytes(randEvenModulus(i))
+		t.Run(fmt.Sprintf("even-%dbyte", i), func(t *testing.T) {
+			testExpMod(t, mod, r)
+		})
+	}
+}
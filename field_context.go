@@ -2,39 +2,50 @@ package evmmax_arith
 
 import (
 	"encoding/binary"
-	"errors"
-	"fmt"
 	"math"
 	"math/big"
 	"math/bits"
 )
 
-const maxModulusSize = 96 // 768 bits maximum modulus width
-
-// 256 * 768 bit buffer for writing values out before mutating register space
-var outputWriteBuf [3072]uint64
+const maxModulusSize = 512 // 4096 bits maximum modulus width
 
 // FieldContext represents a modulus, an allocated space of reduced field
 // elements, and any internal state necessary to perform efficient modular
 // addition subtraction and multiplication on elements within the space,
 // load/store them to/from the space.
+//
+// A FieldContext is not safe for concurrent use by multiple goroutines: callers
+// must serialize access to a single context, whose arithmetic may hold
+// buffers for intermediate values.  Distinct contexts share no mutable state
+// and can be used concurrently from different goroutines.
 type FieldContext struct {
 	Modulus []uint64
 	R2      []uint64
+	r3      []uint64 // R**3 % mod, used to convert inverses to Montgomery form
 	modInv  uint64
 
 	useMontgomeryRepr bool // true if values are represented in montgomery form internally
 	isModulusBinary   bool
 
 	scratchSpace []uint64
-	AddSubCost   uint64
-	MulCost      uint64
+	// buffer (sized to the scratch space) for writing results out before
+	// mutating the scratch space
+	outputWriteBuf []uint64
+	AddSubCost     uint64 // cost of a single modular addition or subtraction
+	MulCost        uint64 // cost of a single modular multiplication
 
 	addMod addOrSubFunc
 	subMod addOrSubFunc
 	mulMod mulFunc
+	sqrMod sqrFunc
 
 	one                   []uint64
+	oneRepr               []uint64    // one in the internal representation
+	sqrt                  *sqrtParams // see sqrtParams
+	sqrtDone              bool        // true once sqrt is computed
+	crt                   *crtContext // non-nil for even moduli which are not a power of two
+	backend               Backend
+	constTimeStore        bool // see WithConstantTimeStore
 	modulusInt            *big.Int
 	elemSize              uint
 	scratchSpaceElemCount uint
@@ -48,72 +59,117 @@ func isModulusBinary(modulus *big.Int) bool {
 	return false
 }
 
-// NewFieldContext instantiates a field context with a given big-endian modulus, number of field elements
-func NewFieldContext(modBytes []byte, scratchSize int) (*FieldContext, error) {
+// NewFieldContext instantiates a field context with a given big-endian modulus, number of field elements.
+// Odd moduli use Montgomery arithmetic (or canonical form, see BackendBarrett), powers of two use native limb
+// arithmetic, and other even moduli 2**k * q are split into contexts for q and 2**k with values recombined by CRT.
+// Invalid parameters are reported with the Err* sentinel errors declared in errors.go.
+// The options select the arithmetic backend (see WithBackend).
+func NewFieldContext(modBytes []byte, scratchSize int, opts ...Option) (*FieldContext, error) {
 	if len(modBytes) > maxModulusSize {
-		return nil, errors.New("modulus cannot be greater than 768 bits")
+		return nil, ErrModulusTooLarge
 	}
 	if len(modBytes) == 0 {
-		return nil, errors.New("modulus must be non-empty")
+		return nil, ErrModulusEmpty
 	}
 	if modBytes[0] == 0 {
-		return nil, errors.New("most significant byte of modulus must not be zero")
+		return nil, ErrModulusLeadingZero
 	}
-	if scratchSize == 0 {
-		return nil, errors.New("scratch space must have non-zero size")
+	if scratchSize <= 0 {
+		return nil, ErrScratchSpaceEmpty
 	}
 	if scratchSize > 256 {
-		return nil, errors.New("scratch space can allocate a maximum of 256 field elements")
+		return nil, ErrScratchSpaceTooLarge
+	}
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return nil, err
 	}
 
 	mod := new(big.Int).SetBytes(modBytes)
+	if cfg.backend == BackendPseudoMersenne && mod.Bit(0) == 0 {
+		return nil, ErrBackendUnsupported
+	}
 	paddedSize := int(math.Ceil(float64(len(modBytes))/8.0)) * 8
 	if isModulusBinary(mod) {
+		oneRepr := make([]uint64, paddedSize/8)
+		if mod.Cmp(big.NewInt(1)) != 0 {
+			oneRepr[0] = 1
+		}
+		mulMod, sqrMod, addMod, subMod := binaryArith(paddedSize/8, cfg.backend)
 		return &FieldContext{
 			Modulus:               bytesToLimbs(modBytes),
-			mulMod:                MulModBinary,
-			addMod:                AddModBinary,
-			subMod:                SubModBinary,
+			mulMod:                mulMod,
+			sqrMod:                sqrMod,
+			addMod:                addMod,
+			subMod:                subMod,
 			scratchSpace:          make([]uint64, (paddedSize/8)*scratchSize),
+			outputWriteBuf:        make([]uint64, (paddedSize/8)*scratchSize),
 			scratchSpaceElemCount: uint(scratchSize),
+			oneRepr:               oneRepr,
 			modulusInt:            mod,
 			elemSize:              uint(paddedSize),
 			useMontgomeryRepr:     false,
 			isModulusBinary:       true,
+			backend:               cfg.backend,
+			constTimeStore:        cfg.constTimeStore,
+			AddSubCost:            addSubCost(uint64(paddedSize / 8)),
+			MulCost:               mulCost(uint64(paddedSize/8), true),
 		}, nil
 	}
 	if modBytes[len(modBytes)-1]%2 == 0 {
-		return nil, errors.New("modulus cannot be even")
+		return newCRTFieldContext(mod, paddedSize, scratchSize, opts)
 	}
-	modInv := negModInverse(mod.Uint64())
-
-	r2 := new(big.Int).Lsh(big.NewInt(1), uint(paddedSize)*8*2)
-	r2.Mod(r2, mod)
-
-	r2Bytes := r2.Bytes()
 	if len(modBytes) < paddedSize {
 		modBytes = append(make([]byte, paddedSize-len(modBytes)), modBytes...)
 	}
-	if len(r2Bytes) < paddedSize {
-		r2Bytes = append(make([]byte, paddedSize-len(r2Bytes)), r2Bytes...)
-	}
-
 	one := make([]uint64, paddedSize/8)
 	one[0] = 1
 
+	modLimbs := bytesToLimbs(modBytes)
 	m := FieldContext{
-		Modulus:               bytesToLimbs(modBytes),
-		modInv:                modInv,
-		R2:                    bytesToLimbs(r2Bytes),
-		mulMod:                mulmodPreset[paddedSize/8-1],
-		addMod:                addmodPreset[paddedSize/8-1],
-		subMod:                submodPreset[paddedSize/8-1],
+		Modulus:               modLimbs,
 		scratchSpace:          make([]uint64, (paddedSize/8)*scratchSize),
+		outputWriteBuf:        make([]uint64, (paddedSize/8)*scratchSize),
 		scratchSpaceElemCount: uint(scratchSize),
 		one:                   one,
 		modulusInt:            mod,
 		elemSize:              uint(paddedSize),
-		useMontgomeryRepr:     true,
+		backend:               cfg.backend,
+		constTimeStore:        cfg.constTimeStore,
+		AddSubCost:            addSubCost(uint64(paddedSize / 8)),
+		MulCost:               mulCost(uint64(paddedSize/8), false),
+	}
+	pm := selectPseudoMersenne(mod, modLimbs, cfg.backend)
+	switch {
+	case pm != nil:
+		m.mulMod, m.sqrMod, m.addMod, m.subMod = pseudoMersenneArith(pm)
+		m.backend = BackendPseudoMersenne
+	case cfg.backend == BackendPseudoMersenne:
+		return nil, ErrBackendUnsupported
+	case cfg.backend == BackendBarrett:
+		m.mulMod, m.sqrMod, m.addMod, m.subMod = barrettArith(newBarrett(mod, len(modLimbs)))
+	default:
+		r2 := new(big.Int).Lsh(big.NewInt(1), uint(paddedSize)*8*2)
+		r2.Mod(r2, mod)
+		r2Bytes := r2.Bytes()
+		if len(r2Bytes) < paddedSize {
+			r2Bytes = append(make([]byte, paddedSize-len(r2Bytes)), r2Bytes...)
+		}
+		m.R2 = bytesToLimbs(r2Bytes)
+		m.modInv = negModInverse(mod.Uint64())
+		m.useMontgomeryRepr = true
+		m.mulMod, m.sqrMod, m.addMod, m.subMod = montgomeryArith(modLimbs, cfg.backend)
+	}
+
+	if m.useMontgomeryRepr {
+		// one in Montgomery form: R % mod
+		m.oneRepr = make([]uint64, paddedSize/8)
+		m.mulMod(m.oneRepr, m.R2, one, m.Modulus, m.modInv)
+		m.r3 = make([]uint64, paddedSize/8)
+		m.mulMod(m.r3, m.R2, m.R2, m.Modulus, m.modInv)
+	} else {
+		// values are kept in canonical form
+		m.oneRepr = one
 	}
 
 	return &m, nil
@@ -124,6 +180,11 @@ func (f *FieldContext) IsModulusBinary() bool {
 	return f.isModulusBinary
 }
 
+// Backend returns the arithmetic backend used by this context
+func (f *FieldContext) Backend() Backend {
+	return f.backend
+}
+
 // NumElems returns the number of field elements allocated in this context
 func (f *FieldContext) NumElems() uint {
 	return f.scratchSpaceElemCount
@@ -133,6 +194,9 @@ func (f *FieldContext) NumElems() uint {
 // field element's size is the size of the modulus padded to be a multiple
 // of 64 bits.
 func (f *FieldContext) AllocedSize() uint {
+	if f.crt != nil {
+		return f.crt.odd.AllocedSize() + f.crt.binary.AllocedSize()
+	}
 	return uint(len(f.scratchSpace) * 8)
 }
 
@@ -165,8 +229,13 @@ func negModInverse(mod uint64) uint64 {
 // placing the result in [out, out+outStride, out+outStride*2, ..., out+outStride*(count - 1)].
 //
 // inputs/outputs can overlap without affecting the result.  it is not validated
-// that inputs are within bounds.
+// that inputs are within bounds: use MulModChecked for untrusted inputs.
 func (m *FieldContext) MulMod(out, outStride, x, xStride, y, yStride, count uint) {
+	if m.crt != nil {
+		m.crt.odd.MulMod(out, outStride, x, xStride, y, yStride, count)
+		m.crt.binary.MulMod(out, outStride, x, xStride, y, yStride, count)
+		return
+	}
 	elemSize := uint(len(m.Modulus))
 
 	// perform the multiplications
@@ -174,16 +243,50 @@ func (m *FieldContext) MulMod(out, outStride, x, xStride, y, yStride, count uint
 		xSrc := (x + i*xStride) * elemSize
 		ySrc := (y + i*yStride) * elemSize
 		dst := (out + i*outStride) * elemSize
-		m.mulMod(outputWriteBuf[dst:dst+elemSize],
+		m.mulMod(m.outputWriteBuf[dst:dst+elemSize],
 			m.scratchSpace[xSrc:xSrc+elemSize],
 			m.scratchSpace[ySrc:ySrc+elemSize],
 			m.Modulus,
 			m.modInv)
 	}
 	// copy the result from the intermediate scratch buffer back into the context's field element space
-	for i := out; i < out+(count*outStride); i += outStride {
-		offset := i * elemSize
-		copy(m.scratchSpace[offset:offset+elemSize], outputWriteBuf[offset:offset+elemSize])
+	m.writeBack(out, outStride, count)
+}
+
+// SqrMod computes 'count' modular squarings of values at offsets
+// [x, x+xStride, x+xStride*2, ..., x+xStride*(count - 1)]
+// placing the result in [out, out+outStride, out+outStride*2, ..., out+outStride*(count - 1)].
+//
+// inputs/outputs can overlap without affecting the result.  it is not validated
+// that inputs are within bounds: use SqrModChecked for untrusted inputs.
+func (m *FieldContext) SqrMod(out, outStride, x, xStride, count uint) {
+	if m.crt != nil {
+		m.crt.odd.SqrMod(out, outStride, x, xStride, count)
+		m.crt.binary.SqrMod(out, outStride, x, xStride, count)
+		return
+	}
+	elemSize := uint(len(m.Modulus))
+
+	// perform the squarings
+	for i := uint(0); i < count; i++ {
+		xSrc := (x + i*xStride) * elemSize
+		dst := (out + i*outStride) * elemSize
+		m.sqrMod(m.outputWriteBuf[dst:dst+elemSize],
+			m.scratchSpace[xSrc:xSrc+elemSize],
+			m.Modulus,
+			m.modInv)
+	}
+	// copy the result from the intermediate scratch buffer back into the context's field element space
+	m.writeBack(out, outStride, count)
+}
+
+// writeBack copies 'count' results at offsets [out, out+outStride, ..., out+outStride*(count - 1)]
+// from the output buffer into the scratch space.
+func (m *FieldContext) writeBack(out, outStride, count uint) {
+	elemSize := uint(len(m.Modulus))
+	for i := uint(0); i < count; i++ {
+		offset := (out + i*outStride) * elemSize
+		copy(m.scratchSpace[offset:offset+elemSize], m.outputWriteBuf[offset:offset+elemSize])
 	}
 }
 
@@ -193,8 +296,13 @@ func (m *FieldContext) MulMod(out, outStride, x, xStride, y, yStride, count uint
 // placing the result in [out, out+outStride, out+outStride*2, ..., out+outStride*(count - 1)].
 //
 // inputs/outputs can overlap without affecting the result.  it is not validated
-// that inputs are within bounds.
+// that inputs are within bounds: use SubModChecked for untrusted inputs.
 func (m *FieldContext) SubMod(out, outStride, x, xStride, y, yStride, count uint) {
+	if m.crt != nil {
+		m.crt.odd.SubMod(out, outStride, x, xStride, y, yStride, count)
+		m.crt.binary.SubMod(out, outStride, x, xStride, y, yStride, count)
+		return
+	}
 	elemSize := uint(len(m.Modulus))
 
 	// perform the subtractions
@@ -202,16 +310,13 @@ func (m *FieldContext) SubMod(out, outStride, x, xStride, y, yStride, count uint
 		xSrc := (x + i*xStride) * elemSize
 		ySrc := (y + i*yStride) * elemSize
 		dst := (out + i*outStride) * elemSize
-		m.subMod(outputWriteBuf[dst:dst+elemSize],
+		m.subMod(m.outputWriteBuf[dst:dst+elemSize],
 			m.scratchSpace[xSrc:xSrc+elemSize],
 			m.scratchSpace[ySrc:ySrc+elemSize],
 			m.Modulus)
 	}
 	// copy the results from the intermediate scratch buffer back into the context's field element space
-	for i := out; i < out+(count*outStride); i += outStride {
-		offset := i * elemSize
-		copy(m.scratchSpace[offset:offset+elemSize], outputWriteBuf[offset:offset+elemSize])
-	}
+	m.writeBack(out, outStride, count)
 }
 
 // AddMod computes 'count' modular additions, pairwise adding values
@@ -220,8 +325,13 @@ func (m *FieldContext) SubMod(out, outStride, x, xStride, y, yStride, count uint
 // placing the result in [out, out+outStride, out+outStride*2, ..., out+outStride*(count - 1)].
 //
 // inputs/outputs can overlap without affecting the result.  it is not validated
-// that inputs are within bounds.
+// that inputs are within bounds: use AddModChecked for untrusted inputs.
 func (m *FieldContext) AddMod(out, outStride, x, xStride, y, yStride, count uint) {
+	if m.crt != nil {
+		m.crt.odd.AddMod(out, outStride, x, xStride, y, yStride, count)
+		m.crt.binary.AddMod(out, outStride, x, xStride, y, yStride, count)
+		return
+	}
 	elemSize := uint(len(m.Modulus))
 
 	// perform the additions
@@ -229,37 +339,53 @@ func (m *FieldContext) AddMod(out, outStride, x, xStride, y, yStride, count uint
 		xSrc := (x + i*xStride) * elemSize
 		ySrc := (y + i*yStride) * elemSize
 		dst := (out + i*outStride) * elemSize
-		m.addMod(outputWriteBuf[dst:dst+elemSize],
+		m.addMod(m.outputWriteBuf[dst:dst+elemSize],
 			m.scratchSpace[xSrc:xSrc+elemSize],
 			m.scratchSpace[ySrc:ySrc+elemSize],
 			m.Modulus)
 	}
 	// copy the results from the intermediate scratch buffer back into the context's field element space
-	for i := out; i < out+(count*outStride); i += outStride {
-		offset := i * elemSize
-		copy(m.scratchSpace[offset:offset+elemSize], outputWriteBuf[offset:offset+elemSize])
-	}
+	m.writeBack(out, outStride, count)
 }
 
 // Store takes a byte slice representing 'count' field elements, each of which
 // is sized to the modulus length padded to the nearest 64 bits.  It places them
 // in the allocated field element space starting at offset dst.
 //
-// does not perform bounds checks on the inputs.  Checks that each field element in 'from'
-// is reduced by the modulus.
+// does not perform bounds checks on the inputs: use StoreChecked for untrusted
+// inputs.  Checks that each field element in 'from' is reduced by the modulus
+// before modifying the field element space, returning a *ValueNotReducedError
+// otherwise.  The check is constant time if the context was created with
+// WithConstantTimeStore, but for even moduli which are not a power of two the
+// conversion of values into their residues is not.
 func (m *FieldContext) Store(dst, count uint, from []byte) error {
 	elemSize := uint(len(m.Modulus))
 
+	vals := make([][]uint64, count)
+	// set once an unreduced value has been found in constant time mode
+	var found, firstIdx uint64
 	for i := uint(0); i < count; i++ {
 		srcIdx := i * elemSize * 8
-		dstIdx := dst*elemSize + i*elemSize
 
 		// swap big-endian bytes to ascending-significance-ordered little-endian limbs internal repr
-		val := bytesToLimbs(from[srcIdx : srcIdx+elemSize*8])
-		if !lt(val, m.Modulus) {
-			return fmt.Errorf("value (%+v) must be less than modulus (%+v)", val, m.Modulus)
+		vals[i] = bytesToLimbs(from[srcIdx : srcIdx+elemSize*8])
+		if m.constTimeStore {
+			notReduced := ^ltMask(vals[i], m.Modulus)
+			firstIdx |= uint64(i) & notReduced &^ found
+			found |= notReduced
+		} else if !lt(vals[i], m.Modulus) {
+			return &ValueNotReducedError{Index: i}
 		}
+	}
+	if found != 0 {
+		return &ValueNotReducedError{Index: uint(firstIdx)}
+	}
+	if m.crt != nil {
+		return m.crt.store(dst, count, from, m.elemSize)
+	}
 
+	for i, val := range vals {
+		dstIdx := (dst + uint(i)) * elemSize
 		if m.useMontgomeryRepr {
 			// convert to Montgomery form
 			m.mulMod(m.scratchSpace[dstIdx:dstIdx+elemSize],
@@ -270,7 +396,6 @@ func (m *FieldContext) Store(dst, count uint, from []byte) error {
 		} else {
 			copy(m.scratchSpace[dstIdx:dstIdx+elemSize], val[:])
 		}
-		dstIdx++
 	}
 	return nil
 }
@@ -278,8 +403,13 @@ func (m *FieldContext) Store(dst, count uint, from []byte) error {
 // Load loads 'count' number of field elements starting at from, and placing
 // them into dst.
 //
-// does not perform any validity checks on the inputs.
+// does not perform any validity checks on the inputs: use LoadChecked for
+// untrusted inputs.
 func (m *FieldContext) Load(dst []byte, from, count int) {
+	if m.crt != nil {
+		m.crt.load(dst, from, count, m.elemSize)
+		return
+	}
 	elemSize := len(m.Modulus)
 	var dstIdx int
 	for srcIdx := from; srcIdx < from+count; srcIdx++ {
@@ -0,0 +1,24 @@
+package evmmax_arith
+
+// fixedModulus is the arithmetic specialized for a well-known modulus, with
+// the modulus and its inverse baked in as constants.  The generated
+// fixedModuli registry lists them.
+type fixedModulus struct {
+	name string
+	mod  []uint64
+	mul  mulFunc
+	sqr  sqrFunc
+	add  addOrSubFunc
+	sub  addOrSubFunc
+}
+
+// lookupFixedModulus returns the specialized arithmetic for mod, or nil if it
+// is not a registered modulus.
+func lookupFixedModulus(mod []uint64) *fixedModulus {
+	for i := range fixedModuli {
+		if fixed := &fixedModuli[i]; len(fixed.mod) == len(mod) && eq(fixed.mod, mod) {
+			return fixed
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package evmmax_arith
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// TestFixedModuli checks the arithmetic specialized for each registered
+// modulus against the reference implementation, and that field contexts of
+// the modulus compute correct results.
+func TestFixedModuli(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, fixed := range fixedModuli {
+		t.Run(fixed.name, func(t *testing.T) {
+			modInt := limbsToInt(fixed.mod)
+			if !modInt.ProbablyPrime(20) {
+				t.Fatalf("modulus %x is not prime", modInt)
+			}
+			if lookupFixedModulus(fixed.mod) == nil {
+				t.Fatal("modulus not found in the registry")
+			}
+			moduli := [][]uint64{fixed.mod}
+			checkMul(t, fixed.mul, montMulGeneric, moduli)
+			sqr := func(out, x, y, mod []uint64, modInv uint64) {
+				fixed.sqr(out, x, mod, modInv)
+			}
+			square := func(out, x, y, mod []uint64, modInv uint64) {
+				montMulGeneric(out, x, x, mod, modInv)
+			}
+			checkMul(t, sqr, square, moduli)
+			checkAddOrSub(t, fixed.add, addModGeneric, moduli)
+			checkAddOrSub(t, fixed.sub, subModGeneric, moduli)
+
+			fieldCtx, err := NewFieldContext(modInt.Bytes(), 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			elemSize := uint64(fieldCtx.ElemSize())
+			xInt, yInt := randBigInt(r, modInt), randBigInt(r, modInt)
+			fieldCtx.Store(0, 1, PadBytes(xInt.Bytes(), elemSize))
+			fieldCtx.Store(1, 1, PadBytes(yInt.Bytes(), elemSize))
+			fieldCtx.MulMod(2, 1, 0, 1, 1, 1, 1)
+			fieldCtx.SubMod(2, 1, 2, 1, 0, 1, 1)
+
+			expected := new(big.Int).Mul(xInt, yInt)
+			expected.Sub(expected, xInt)
+			expected.Mod(expected, modInt)
+			res := make([]byte, elemSize)
+			fieldCtx.Load(res, 2, 1)
+			if new(big.Int).SetBytes(res).Cmp(expected) != 0 {
+				t.Fatalf("mismatch: %x != %x", res, expected)
+			}
+		})
+	}
+
+	// a modulus differing in one limb is not specialized
+	mod := append([]uint64{}, fixedModuli[0].mod...)
+	mod[1] ^= 1
+	if lookupFixedModulus(mod) != nil {
+		t.Fatalf("unexpected specialized arithmetic for modulus %x", mod)
+	}
+}
+
+// BenchmarkFixedModuli compares the multiplication and squaring specialized
+// for each registered modulus against every generated variant available for
+// it.  montgomeryArith selects the specialized multiplication unless the
+// amd64 assembly is selected, and always the specialized squaring.
+func BenchmarkFixedModuli(b *testing.B) {
+	for _, fixed := range fixedModuli {
+		limbs := len(fixed.mod)
+		b.Run(fmt.Sprintf("montmul-%s-fixed", fixed.name), func(b *testing.B) {
+			benchmarkMontMul(b, fixed.mul, fixed.mod)
+		})
+		for _, v := range montVariants {
+			mul := v.mulFunc(limbs)
+			if mul == nil || !v.applicable(fixed.mod) {
+				continue
+			}
+			b.Run(fmt.Sprintf("montmul-%s-%s", fixed.name, v), func(b *testing.B) {
+				benchmarkMontMul(b, mul, fixed.mod)
+			})
+		}
+		b.Run(fmt.Sprintf("montsqr-%s-fixed", fixed.name), func(b *testing.B) {
+			benchmarkMontMul(b, func(out, x, _, mod []uint64, modInv uint64) {
+				fixed.sqr(out, x, mod, modInv)
+			}, fixed.mod)
+		})
+		b.Run(fmt.Sprintf("montsqr-%s-generated", fixed.name), func(b *testing.B) {
+			benchmarkMontMul(b, func(out, x, _, mod []uint64, modInv uint64) {
+				sqrmodPreset[limbs-1](out, x, mod, modInv)
+			}, fixed.mod)
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package evmmax_arith
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+// fuzzModulus interprets the fuzzer provided bytes as an odd modulus of up to
+// maxLimbs limbs, returning its limbs and the limb count.
+func fuzzModulus(modBytes []byte, maxLimbs int) (*big.Int, []uint64, bool) {
+	if len(modBytes) == 0 || len(modBytes) > maxLimbs*8 {
+		return nil, nil, false
+	}
+	mod := new(big.Int).SetBytes(modBytes)
+	mod.SetBit(mod, 0, 1)
+	limbs := (len(modBytes) + 7) / 8
+	return mod, bytesToLimbs(PadBytes(mod.Bytes(), uint64(limbs*8))), true
+}
+
+// fuzzOperand reduces the fuzzer provided bytes modulo mod.
+func fuzzOperand(val []byte, mod *big.Int, limbs int) (*big.Int, []uint64) {
+	v := new(big.Int).SetBytes(val)
+	v.Mod(v, mod)
+	return v, bytesToLimbs(PadBytes(v.Bytes(), uint64(limbs*8)))
+}
+
+// addArithSeeds adds seeds with the operands 0, 1 and mod-1 for moduli
+// consisting of all-ones limbs, and a few other moduli.
+func addArithSeeds(f *testing.F) {
+	for limbs := 1; limbs <= 12; limbs++ {
+		mods := [][]byte{
+			limbsToBytes(MaxModulus(limbs)),
+			append([]byte{1}, make([]byte, limbs*8-1)...),
+		}
+		for _, mod := range mods {
+			mod[len(mod)-1] |= 1
+			max := new(big.Int).SetBytes(mod)
+			max.Sub(max, big.NewInt(1))
+			for _, x := range [][]byte{{}, {1}, max.Bytes()} {
+				for _, y := range [][]byte{{}, {1}, max.Bytes()} {
+					f.Add(mod, x, y)
+				}
+			}
+		}
+	}
+}
+
+func checkFuzzResult(t *testing.T, op string, got []uint64, expected, x, y, mod *big.Int) {
+	if limbsToInt(got).Cmp(expected) != 0 {
+		t.Fatalf("%s(%x, %x) mod %x: got %x, expected %x", op, x, y, mod, limbsToInt(got), expected)
+	}
+}
+
+func FuzzMontMul(f *testing.F) {
+	addArithSeeds(f)
+	f.Fuzz(func(t *testing.T, modBytes, xBytes, yBytes []byte) {
+		mod, modLimbs, ok := fuzzModulus(modBytes, len(mulmodPreset))
+		if !ok {
+			return
+		}
+		limbs := len(modLimbs)
+		x, xLimbs := fuzzOperand(xBytes, mod, limbs)
+		y, yLimbs := fuzzOperand(yBytes, mod, limbs)
+		modInv := negModInverse(modLimbs[0])
+
+		// x * y * R**-1 % mod
+		rInv := new(big.Int).Lsh(big.NewInt(1), uint(limbs*64))
+		rInv.ModInverse(rInv, mod)
+		expected := new(big.Int).Mul(x, y)
+		expected.Mul(expected, rInv)
+		expected.Mod(expected, mod)
+
+		out := make([]uint64, limbs)
+		for _, v := range montVariants {
+			if mul := v.mulFunc(limbs); mul != nil && v.applicable(modLimbs) {
+				mul(out, xLimbs, yLimbs, modLimbs, modInv)
+				checkFuzzResult(t, fmt.Sprintf("MontMul (%s)", v), out, expected, x, y, mod)
+			}
+		}
+
+		expected.Mul(x, x)
+		expected.Mul(expected, rInv)
+		expected.Mod(expected, mod)
+		sqrmodPreset[limbs-1](out, xLimbs, modLimbs, modInv)
+		checkFuzzResult(t, "MontSqr", out, expected, x, x, mod)
+	})
+}
+
+func FuzzAddMod(f *testing.F) {
+	addArithSeeds(f)
+	f.Fuzz(func(t *testing.T, modBytes, xBytes, yBytes []byte) {
+		mod, modLimbs, ok := fuzzModulus(modBytes, len(addmodPreset))
+		if !ok {
+			return
+		}
+		limbs := len(modLimbs)
+		x, xLimbs := fuzzOperand(xBytes, mod, limbs)
+		y, yLimbs := fuzzOperand(yBytes, mod, limbs)
+
+		expected := new(big.Int).Add(x, y)
+		expected.Mod(expected, mod)
+
+		out := make([]uint64, limbs)
+		addmodPreset[limbs-1](out, xLimbs, yLimbs, modLimbs)
+		checkFuzzResult(t, "AddMod", out, expected, x, y, mod)
+	})
+}
+
+func FuzzSubMod(f *testing.F) {
+	addArithSeeds(f)
+	f.Fuzz(func(t *testing.T, modBytes, xBytes, yBytes []byte) {
+		mod, modLimbs, ok := fuzzModulus(modBytes, len(submodPreset))
+		if !ok {
+			return
+		}
+		limbs := len(modLimbs)
+		x, xLimbs := fuzzOperand(xBytes, mod, limbs)
+		y, yLimbs := fuzzOperand(yBytes, mod, limbs)
+
+		expected := new(big.Int).Sub(x, y)
+		expected.Mod(expected, mod)
+
+		out := make([]uint64, limbs)
+		submodPreset[limbs-1](out, xLimbs, yLimbs, modLimbs)
+		checkFuzzResult(t, "SubMod", out, expected, x, y, mod)
+	})
+}
+
+// FuzzStoreLoad checks that values less than the modulus survive a round trip
+// through the context's internal representation, and that others are rejected.
+func FuzzStoreLoad(f *testing.F) {
+	for _, mod := range [][]byte{{7}, {8}, {12}, limbsToBytes(MaxModulus(4)), randBinaryModulus(32)} {
+		max := new(big.Int).SetBytes(mod)
+		max.Sub(max, big.NewInt(1))
+		f.Add(mod, []byte{})
+		f.Add(mod, []byte{1})
+		f.Add(mod, max.Bytes())
+		f.Add(mod, mod)
+	}
+	f.Fuzz(func(t *testing.T, modBytes, val []byte) {
+		fieldCtx, err := NewFieldContext(modBytes, 1)
+		if err != nil {
+			return
+		}
+		elemSize := fieldCtx.ElemSize()
+		if uint(len(val)) > elemSize {
+			return
+		}
+		val = PadBytes(val, uint64(elemSize))
+
+		err = fieldCtx.StoreChecked(0, 1, val)
+		if new(big.Int).SetBytes(val).Cmp(fieldCtx.modulusInt) >= 0 {
+			if !errors.Is(err, ErrValueNotReduced) {
+				t.Fatalf("storing %x mod %x: expected ErrValueNotReduced, got %v", val, modBytes, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("storing %x mod %x: %v", val, modBytes, err)
+		}
+		res := make([]byte, elemSize)
+		if err := fieldCtx.LoadChecked(res, 0, 1); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(res, val) {
+			t.Fatalf("round trip of %x mod %x returned %x", val, modBytes, res)
+		}
+	})
+}
+
+const (
+	fuzzBatchElems  = 16
+	fuzzBatchOpSize = 8
+)
+
+// FuzzBatchOps runs a program of batch operations, which can have overlapping
+// and out of bounds operands, against a big.Int model of the scratch space.
+// Each operation is encoded in fuzzBatchOpSize bytes: the operation, then the
+// out, x and y offsets and strides, then the count.
+func FuzzBatchOps(f *testing.F) {
+	f.Add([]byte{7}, []byte{2, 1, 1, 0, 1, 0, 1, 16})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, []byte{
+		0, 0, 1, 1, 1, 2, 1, 8,
+		1, 15, 0, 0, 1, 2, 0, 4,
+		3, 1, 2, 0, 1, 0, 0, 8,
+	})
+	f.Add([]byte{12}, []byte{2, 3, 1, 0, 0, 4, 1, 5, 0, 0, 3, 0, 0, 0, 0, 6})
+	f.Add(randBinaryModulus(8), []byte{2, 0, 1, 1, 1, 2, 1, 15, 1, 0, 0, 0, 0, 0, 0, 17})
+	f.Fuzz(func(t *testing.T, modBytes, program []byte) {
+		fieldCtx, err := NewFieldContext(modBytes, fuzzBatchElems)
+		if err != nil {
+			return
+		}
+		mod := fieldCtx.modulusInt
+		elemSize := fieldCtx.ElemSize()
+
+		// initialize the scratch space with i**3 % mod
+		model := make([]*big.Int, fuzzBatchElems)
+		initial := make([]byte, 0, fuzzBatchElems*elemSize)
+		for i := range model {
+			model[i] = new(big.Int).Exp(big.NewInt(int64(i)), big.NewInt(3), mod)
+			initial = append(initial, PadBytes(model[i].Bytes(), uint64(elemSize))...)
+		}
+		if err := fieldCtx.StoreChecked(0, fuzzBatchElems, initial); err != nil {
+			t.Fatal(err)
+		}
+
+		for len(program) >= fuzzBatchOpSize {
+			op := program[0] % 4
+			out, outStride := uint(program[1]), uint(program[2])
+			x, xStride := uint(program[3]), uint(program[4])
+			y, yStride := uint(program[5]), uint(program[6])
+			count := uint(program[7])
+			program = program[fuzzBatchOpSize:]
+
+			switch op {
+			case 0:
+				err = fieldCtx.AddModChecked(out, outStride, x, xStride, y, yStride, count)
+			case 1:
+				err = fieldCtx.SubModChecked(out, outStride, x, xStride, y, yStride, count)
+			case 2:
+				err = fieldCtx.MulModChecked(out, outStride, x, xStride, y, yStride, count)
+			case 3:
+				y, yStride = x, xStride
+				err = fieldCtx.SqrModChecked(out, outStride, x, xStride, count)
+			}
+
+			inBounds := func(offset, stride uint) bool {
+				return count == 0 || offset+(count-1)*stride < fuzzBatchElems
+			}
+			if !inBounds(out, outStride) || !inBounds(x, xStride) || !inBounds(y, yStride) {
+				if !errors.Is(err, ErrOutOfBounds) {
+					t.Fatalf("expected ErrOutOfBounds, got %v", err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// all inputs are read before any output is written
+			results := make([]*big.Int, count)
+			for i := uint(0); i < count; i++ {
+				a, b := model[x+i*xStride], model[y+i*yStride]
+				res := new(big.Int)
+				switch op {
+				case 0:
+					res.Add(a, b)
+				case 1:
+					res.Sub(a, b)
+				default:
+					res.Mul(a, b)
+				}
+				results[i] = res.Mod(res, mod)
+			}
+			for i, res := range results {
+				model[out+uint(i)*outStride] = res
+			}
+		}
+
+		res := make([]byte, fuzzBatchElems*elemSize)
+		if err := fieldCtx.LoadChecked(res, 0, fuzzBatchElems); err != nil {
+			t.Fatal(err)
+		}
+		for i, expected := range model {
+			got := new(big.Int).SetBytes(res[uint(i)*elemSize : uint(i+1)*elemSize])
+			if got.Cmp(expected) != 0 {
+				t.Fatalf("element %d mod %x: got %x, expected %x", i, mod, got, expected)
+			}
+		}
+	})
+}
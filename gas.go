@@ -0,0 +1,141 @@
+package evmmax_arith
+
+import (
+	"math"
+	"math/bits"
+)
+
+// The cost model below follows the structure of the EVMMAX proposals: the
+// cost of each arithmetic operation is a function of the limb count of the
+// modulus, and batch operations cost the per-op cost multiplied by the number
+// of operations performed.  The constants are fit against benchmarks of the
+// arithmetic selected by NewFieldContext, charging one unit of gas per 25ns of
+// execution: TestFitGasCosts (gasfit_test.go, build tag gasfit) reproduces
+// the measurements and the fit.
+const (
+	// mulCostCoeff / mulCostDenom * limbs**2 approximates the cost of a
+	// Montgomery multiplication.
+	mulCostCoeff = 14
+	mulCostDenom = 100
+
+	// limbs covered by one unit of addition/subtraction cost
+	addSubCostLimbs = 4
+
+	// fixed cost of SETMOD (modulus validation and precomputation).  The
+	// SETMOD constants are fit against the slowest of the moduli timed by
+	// TestFitGasCosts, covering both residue classes of Sqrt and the
+	// pseudo-Mersenne form.
+	setmodBaseCost = 50
+	// cost of SETMOD precomputation per limb of the modulus
+	setmodCostPerLimb = 19
+	// cost of allocating one 32 byte word of scratch space, matching the
+	// linear coefficient of EVM memory expansion
+	setmodCostPerWord = 3
+)
+
+// mulCost returns the cost of a single modular multiplication of values with
+// the given limb count.
+func mulCost(limbs uint64, binary bool) uint64 {
+	// count of partial products computed
+	products := limbs * limbs
+	if binary {
+		// multiplication modulo a power of two computes a truncated product
+		// and skips the reduction
+		products = limbs * (limbs + 1) / 2
+	}
+	cost := (products*mulCostCoeff + mulCostDenom - 1) / mulCostDenom
+	if cost == 0 {
+		cost = 1
+	}
+	return cost
+}
+
+// addSubCost returns the cost of a single modular addition or subtraction of
+// values with the given limb count.
+func addSubCost(limbs uint64) uint64 {
+	return (limbs + addSubCostLimbs - 1) / addSubCostLimbs
+}
+
+// saturatingMul returns x * y, or math.MaxUint64 if the result overflows.
+func saturatingMul(x, y uint64) uint64 {
+	hi, lo := bits.Mul64(x, y)
+	if hi != 0 {
+		return math.MaxUint64
+	}
+	return lo
+}
+
+// saturatingAdd returns x + y, or math.MaxUint64 if the result overflows.
+func saturatingAdd(x, y uint64) uint64 {
+	res, carry := bits.Add64(x, y, 0)
+	if carry != 0 {
+		return math.MaxUint64
+	}
+	return res
+}
+
+// SetModCost returns the cost of instantiating a field context for a
+// big-endian modulus of the given size in bytes with 'scratchSize' allocated
+// field elements.  It does not validate the inputs: see NewFieldContext.
+func SetModCost(modSize, scratchSize uint64) uint64 {
+	limbs := modSize / 8
+	if modSize%8 != 0 {
+		limbs++
+	}
+	allocSize := saturatingMul(saturatingMul(limbs, 8), scratchSize)
+	words := allocSize / 32
+	if allocSize%32 != 0 {
+		words++
+	}
+	precomputeCost := saturatingAdd(setmodBaseCost, saturatingMul(setmodCostPerLimb, limbs))
+	return saturatingAdd(precomputeCost, saturatingMul(words, setmodCostPerWord))
+}
+
+// AddModCost returns the cost of an AddMod performing 'count' additions.
+func (f *FieldContext) AddModCost(count uint) uint64 {
+	return saturatingMul(f.AddSubCost, uint64(count))
+}
+
+// SubModCost returns the cost of a SubMod performing 'count' subtractions.
+func (f *FieldContext) SubModCost(count uint) uint64 {
+	return saturatingMul(f.AddSubCost, uint64(count))
+}
+
+// MulModCost returns the cost of a MulMod performing 'count' multiplications.
+func (f *FieldContext) MulModCost(count uint) uint64 {
+	return saturatingMul(f.MulCost, uint64(count))
+}
+
+// SqrModCost returns the cost of a SqrMod performing 'count' squarings.
+func (f *FieldContext) SqrModCost(count uint) uint64 {
+	return saturatingMul(f.MulCost, uint64(count))
+}
+
+// StoreCost returns the cost of a Store of 'count' field elements.  Each
+// element is validated against the modulus and converted to the internal
+// representation.
+func (f *FieldContext) StoreCost(count uint) uint64 {
+	return saturatingMul(f.conversionCost(), uint64(count))
+}
+
+// LoadCost returns the cost of a Load of 'count' field elements.  Each element
+// is converted from the internal representation.
+func (f *FieldContext) LoadCost(count uint) uint64 {
+	return saturatingMul(f.conversionCost(), uint64(count))
+}
+
+// conversionCost returns the cost of converting a single field element to or
+// from the internal representation.  Like every cost it depends only on the
+// modulus: odd moduli are charged a conversion to or from Montgomery form even
+// if the backend keeps values in canonical form, so that the cost does not
+// depend on the backend selected for the CPU or build.
+func (f *FieldContext) conversionCost() uint64 {
+	if f.crt != nil {
+		// conversion of both residues and recombination
+		return f.crt.odd.conversionCost() + f.crt.binary.conversionCost() + f.MulCost
+	}
+	if f.isModulusBinary {
+		return f.AddSubCost
+	}
+	return f.MulCost + f.AddSubCost
+}
@@ -0,0 +1,89 @@
+package evmmax_arith
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+func TestGasCosts(t *testing.T) {
+	var prevOdd, prevBinary *FieldContext
+	for limbs := 1; limbs <= 12; limbs++ {
+		odd, err := NewFieldContext(limbsToInt(MaxModulus(limbs)).Bytes(), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		binary, err := NewFieldContext(new(big.Int).Lsh(big.NewInt(1), uint(limbs*64-1)).Bytes(), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, f := range []*FieldContext{odd, binary} {
+			if f.AddSubCost == 0 || f.MulCost == 0 {
+				t.Fatalf("%d limbs: costs must be non-zero", limbs)
+			}
+			if f.MulModCost(10) != 10*f.MulCost || f.AddModCost(10) != 10*f.AddSubCost || f.SubModCost(10) != 10*f.AddSubCost || f.SqrModCost(10) != 10*f.MulCost {
+				t.Fatalf("%d limbs: batch cost must be per-op cost multiplied by count", limbs)
+			}
+			// a count of math.MaxUint only overflows the cost with 64-bit uints
+			if bits.UintSize == 64 && f.MulModCost(math.MaxUint) != math.MaxUint64 {
+				t.Fatalf("%d limbs: expected batch cost to saturate", limbs)
+			}
+			if f.StoreCost(0) != 0 || f.LoadCost(2) != 2*f.LoadCost(1) {
+				t.Fatalf("%d limbs: unexpected store/load costs", limbs)
+			}
+		}
+		if binary.MulCost > odd.MulCost {
+			t.Fatalf("%d limbs: binary multiplication must not cost more than Montgomery multiplication", limbs)
+		}
+		if binary.StoreCost(1) >= odd.StoreCost(1) {
+			t.Fatalf("%d limbs: binary store must be cheaper than conversion to Montgomery form", limbs)
+		}
+		if prevOdd != nil && (odd.MulCost < prevOdd.MulCost || binary.MulCost < prevBinary.MulCost) {
+			t.Fatalf("%d limbs: multiplication cost must not decrease with width", limbs)
+		}
+		prevOdd, prevBinary = odd, binary
+	}
+
+	if SetModCost(32, 256) <= SetModCost(32, 1) || SetModCost(96, 1) <= SetModCost(8, 1) {
+		t.Fatalf("setmod cost must increase with modulus and scratch space size")
+	}
+	if SetModCost(math.MaxUint64, math.MaxUint64) < SetModCost(96, 256) {
+		t.Fatalf("expected setmod cost to saturate rather than overflow")
+	}
+}
+
+// TestGasCostsAcrossBackends checks that the costs of a context depend only on
+// its modulus and not on the backend, which is selected per CPU and build.
+func TestGasCostsAcrossBackends(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	var moduli []*big.Int
+	for _, limbs := range []int{1, 4, 5, 6, 7, 12, 13, 32} {
+		moduli = append(moduli, pseudoMersenneTestModuli(limbs)...)
+		moduli = append(moduli, limbsToInt(differentialModuli(r, limbs)[0]))
+		moduli = append(moduli, new(big.Int).SetBytes(randEvenModulus(limbs*8)))
+	}
+	costs := func(f *FieldContext) [6]uint64 {
+		return [6]uint64{f.MulModCost(1), f.SqrModCost(1), f.AddModCost(1), f.SubModCost(1), f.StoreCost(1), f.LoadCost(1)}
+	}
+	for _, mod := range moduli {
+		expected, err := NewFieldContext(mod.Bytes(), 1, WithBackend(BackendReference))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, backend := range []Backend{BackendGenerated, BackendPseudoMersenne, BackendBarrett} {
+			fieldCtx, err := NewFieldContext(mod.Bytes(), 1, WithBackend(backend))
+			if errors.Is(err, ErrBackendUnsupported) {
+				continue
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if costs(fieldCtx) != costs(expected) {
+				t.Fatalf("%x: costs %v of backend %s differ from %v", mod, costs(fieldCtx), fieldCtx.Backend(), costs(expected))
+			}
+		}
+	}
+}
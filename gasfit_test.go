@@ -0,0 +1,147 @@
+//go:build gasfit
+
+package evmmax_arith
+
+import (
+	"flag"
+	"math"
+	"testing"
+)
+
+// Fitting of the constants of the gas cost model (see gas.go) against
+// benchmarks of the arithmetic selected by NewFieldContext.  Each operation is
+// timed for a range of limb counts, a least squares fit of the model
+// (minimizing the relative error) is computed, and the resulting constants
+// are reported next to the committed ones.
+//
+// Run with:
+//
+//	go test -tags gasfit -run FitGasCosts -v [-gasfit.ns-per-gas=25] [-gasfit.fail]
+//
+// The committed constants were obtained with the default flags on an amd64
+// CPU with ADX.  Measurements depend on the CPU and build tags.
+
+var (
+	gasFitNsPerGas = flag.Float64("gasfit.ns-per-gas", 25, "execution time in nanoseconds charged one unit of gas")
+	gasFitFail     = flag.Bool("gasfit.fail", false, "fail if the fitted constants differ from the committed ones by more than gasFitTolerance")
+)
+
+// gasFitTolerance is the relative difference between fitted and committed
+// constants attributed to measurement noise
+const gasFitTolerance = 0.1
+
+// gasFitLimbs are the limb counts timed by TestFitGasCosts
+var gasFitLimbs = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 16, 24, 32, 48, 64}
+
+// nsPerOp times op with testing.Benchmark
+func nsPerOp(op func()) float64 {
+	res := testing.Benchmark(func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			op()
+		}
+	})
+	return float64(res.T.Nanoseconds()) / float64(res.N)
+}
+
+// fitThroughOrigin returns the slope s minimizing the squared relative error
+// of y = s * x, so that narrow and wide moduli weigh alike
+func fitThroughOrigin(x, y []float64) float64 {
+	var num, den float64
+	for i := range x {
+		num += x[i] / y[i]
+		den += x[i] * x[i] / (y[i] * y[i])
+	}
+	return num / den
+}
+
+// fitLinear returns the intercept a and slope s minimizing the squared
+// relative error of y = a + s * x
+func fitLinear(x, y []float64) (float64, float64) {
+	// solve the normal equations of the fit weighted by 1/y**2
+	var w, wx, wy, wxx, wxy float64
+	for i := range x {
+		wi := 1 / (y[i] * y[i])
+		w += wi
+		wx += wi * x[i]
+		wy += wi * y[i]
+		wxx += wi * x[i] * x[i]
+		wxy += wi * x[i] * y[i]
+	}
+	s := (w*wxy - wx*wy) / (w*wxx - wx*wx)
+	return (wy - s*wx) / w, s
+}
+
+// gasFitModuli returns the moduli of the given limb count whose context
+// creation is timed: one of each residue class handled differently by Sqrt
+// (3 mod 4 and 1 mod 8) and one of the pseudo-Mersenne form.
+func gasFitModuli(limbs int) map[string][]uint64 {
+	mod1mod8 := montgomeryModulus(limbs)
+	mod1mod8[0] &^= 0b110
+	return map[string][]uint64{
+		"3 mod 4":         montgomeryModulus(limbs),
+		"1 mod 8":         mod1mod8,
+		"pseudo-Mersenne": MaxModulus(limbs),
+	}
+}
+
+func TestFitGasCosts(t *testing.T) {
+	var products, limbCounts, mulNs, addNs, setmodNs []float64
+	for _, limbs := range gasFitLimbs {
+		mod := montgomeryModulus(limbs)
+		mul, _, add, _ := montgomeryArith(mod, BackendGenerated)
+		modInv := negModInverse(mod[0])
+		x, y, out := make([]uint64, limbs), make([]uint64, limbs), make([]uint64, limbs)
+		for i := range x {
+			x[i] = mod[i] >> 1
+			y[i] = mod[i] >> 2
+		}
+
+		mulTime := nsPerOp(func() { mul(out, x, y, mod, modInv) })
+		addTime := nsPerOp(func() { add(out, x, y, mod) })
+		t.Logf("%4d limbs: mulmod %9.1fns  addmod %7.1fns", limbs, mulTime, addTime)
+		// SETMOD is charged the same for every modulus, so the slowest one
+		// is fitted
+		var setmodTime float64
+		for name, setmodMod := range gasFitModuli(limbs) {
+			modBytes := limbsToBytes(setmodMod)
+			ns := nsPerOp(func() {
+				if _, err := NewFieldContext(modBytes, 1); err != nil {
+					t.Fatal(err)
+				}
+			})
+			t.Logf("%4d limbs: setmod %-15s %9.1fns", limbs, name, ns)
+			setmodTime = math.Max(setmodTime, ns)
+		}
+
+		products = append(products, float64(limbs*limbs))
+		limbCounts = append(limbCounts, float64(limbs))
+		mulNs = append(mulNs, mulTime)
+		addNs = append(addNs, addTime)
+		setmodNs = append(setmodNs, setmodTime)
+	}
+
+	nsPerGas := *gasFitNsPerGas
+	// mulCost: products * mulCostCoeff / mulCostDenom
+	coeff := int(math.Round(fitThroughOrigin(products, mulNs) / nsPerGas * mulCostDenom))
+	// addSubCost: limbs / addSubCostLimbs
+	addLimbs := int(math.Round(nsPerGas / fitThroughOrigin(limbCounts, addNs)))
+	// SetModCost: setmodBaseCost + setmodCostPerLimb * limbs
+	base, perLimb := fitLinear(limbCounts, setmodNs)
+	setmodBase := int(math.Round(base / nsPerGas))
+	setmodPerLimb := int(math.Round(perLimb / nsPerGas))
+
+	for _, c := range []struct {
+		name              string
+		fitted, committed int
+	}{
+		{"mulCostCoeff", coeff, mulCostCoeff},
+		{"addSubCostLimbs", addLimbs, addSubCostLimbs},
+		{"setmodBaseCost", setmodBase, setmodBaseCost},
+		{"setmodCostPerLimb", setmodPerLimb, setmodCostPerLimb},
+	} {
+		t.Logf("%-18s fitted %4d  committed %4d", c.name, c.fitted, c.committed)
+		if math.Abs(float64(c.fitted-c.committed)) > gasFitTolerance*float64(c.committed) && *gasFitFail {
+			t.Errorf("%s: fitted %d differs from the committed %d", c.name, c.fitted, c.committed)
+		}
+	}
+}
@@ -0,0 +1,4 @@
+package evmmax_arith
+
+//go:generate go run ./generator -out .
+//go:generate go run ./generator -out . -limb-bits 32 -max-limbs 24
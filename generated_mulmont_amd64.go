@@ -0,0 +1,34 @@
+// Code generated by the evmmax-arith generator. DO NOT EDIT.
+
+//go:build !purego
+
+package evmmax_arith
+
+//go:noescape
+func mulMontADX64(out, x, y, mod []uint64, modInv uint64)
+
+//go:noescape
+func mulMontADX128(out, x, y, mod []uint64, modInv uint64)
+
+//go:noescape
+func mulMontADX192(out, x, y, mod []uint64, modInv uint64)
+
+//go:noescape
+func mulMontADX256(out, x, y, mod []uint64, modInv uint64)
+
+//go:noescape
+func mulMontADX320(out, x, y, mod []uint64, modInv uint64)
+
+//go:noescape
+func mulMontADX384(out, x, y, mod []uint64, modInv uint64)
+
+// mulmodADXPreset holds the assembly Montgomery multiplication routines, which
+// require the BMI2 and ADX instruction set extensions.
+var mulmodADXPreset = []mulFunc{
+	mulMontADX64,
+	mulMontADX128,
+	mulMontADX192,
+	mulMontADX256,
+	mulMontADX320,
+	mulMontADX384,
+}
@@ -0,0 +1,1163 @@
+// Code generated by the evmmax-arith generator. DO NOT EDIT.
+
+//go:build !purego
+
+#include "textflag.h"
+
+// Montgomery multiplication (CIOS) using MULX, ADCX and ADOX, which require the
+// BMI2 and ADX extensions.
+//
+// Registers:
+//   DX             multiplier for MULX
+//   AX             mod
+//   R14            x, then out
+//   R15            y
+//   R12, R13       lo and hi words of products
+//   BX ... R11     the limbs of the intermediate value t, which are renamed
+//                  instead of shifted after each reduction step
+
+
+// func mulMontADX64(out, x, y, mod []uint64, modInv uint64)
+TEXT ·mulMontADX64(SB), NOSPLIT, $0-104
+	MOVQ x_base+24(FP), R14
+	MOVQ y_base+48(FP), R15
+	MOVQ mod_base+72(FP), AX
+	XORQ BX, BX
+	XORQ CX, CX
+	XORQ SI, SI
+
+	// t += x[0] * y
+	MOVQ 0(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MOVQ $0, R12
+	ADOXQ R12, CX
+	ADCXQ R12, SI
+	ADOXQ R12, SI
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ BX, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MOVQ $0, R12
+	ADOXQ R12, CX
+	ADCXQ R12, SI
+	ADOXQ R12, SI
+
+	// out = t - mod
+	MOVQ out_base+0(FP), R14
+	MOVQ CX, R12
+	SUBQ 0(AX), R12
+	MOVQ R12, 0(R14)
+	SBBQ $0, SI
+
+	// out = t if the subtraction borrowed
+	MOVQ 0(R14), R12
+	CMOVQCS CX, R12
+	MOVQ R12, 0(R14)
+	RET
+
+
+// func mulMontADX128(out, x, y, mod []uint64, modInv uint64)
+TEXT ·mulMontADX128(SB), NOSPLIT, $0-104
+	MOVQ x_base+24(FP), R14
+	MOVQ y_base+48(FP), R15
+	MOVQ mod_base+72(FP), AX
+	XORQ BX, BX
+	XORQ CX, CX
+	XORQ SI, SI
+	XORQ DI, DI
+
+	// t += x[0] * y
+	MOVQ 0(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MOVQ $0, R12
+	ADOXQ R12, SI
+	ADCXQ R12, DI
+	ADOXQ R12, DI
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ BX, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MOVQ $0, R12
+	ADOXQ R12, SI
+	ADCXQ R12, DI
+	ADOXQ R12, DI
+
+	// t += x[1] * y
+	MOVQ 8(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MOVQ $0, R12
+	ADOXQ R12, DI
+	ADCXQ R12, BX
+	ADOXQ R12, BX
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ CX, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MOVQ $0, R12
+	ADOXQ R12, DI
+	ADCXQ R12, BX
+	ADOXQ R12, BX
+
+	// out = t - mod
+	MOVQ out_base+0(FP), R14
+	MOVQ SI, R12
+	SUBQ 0(AX), R12
+	MOVQ R12, 0(R14)
+	MOVQ DI, R12
+	SBBQ 8(AX), R12
+	MOVQ R12, 8(R14)
+	SBBQ $0, BX
+
+	// out = t if the subtraction borrowed
+	MOVQ 0(R14), R12
+	CMOVQCS SI, R12
+	MOVQ R12, 0(R14)
+	MOVQ 8(R14), R12
+	CMOVQCS DI, R12
+	MOVQ R12, 8(R14)
+	RET
+
+
+// func mulMontADX192(out, x, y, mod []uint64, modInv uint64)
+TEXT ·mulMontADX192(SB), NOSPLIT, $0-104
+	MOVQ x_base+24(FP), R14
+	MOVQ y_base+48(FP), R15
+	MOVQ mod_base+72(FP), AX
+	XORQ BX, BX
+	XORQ CX, CX
+	XORQ SI, SI
+	XORQ DI, DI
+	XORQ R8, R8
+
+	// t += x[0] * y
+	MOVQ 0(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MOVQ $0, R12
+	ADOXQ R12, DI
+	ADCXQ R12, R8
+	ADOXQ R12, R8
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ BX, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MOVQ $0, R12
+	ADOXQ R12, DI
+	ADCXQ R12, R8
+	ADOXQ R12, R8
+
+	// t += x[1] * y
+	MOVQ 8(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MOVQ $0, R12
+	ADOXQ R12, R8
+	ADCXQ R12, BX
+	ADOXQ R12, BX
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ CX, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MOVQ $0, R12
+	ADOXQ R12, R8
+	ADCXQ R12, BX
+	ADOXQ R12, BX
+
+	// t += x[2] * y
+	MOVQ 16(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, BX
+	MOVQ $0, R12
+	ADOXQ R12, BX
+	ADCXQ R12, CX
+	ADOXQ R12, CX
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ SI, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, BX
+	MOVQ $0, R12
+	ADOXQ R12, BX
+	ADCXQ R12, CX
+	ADOXQ R12, CX
+
+	// out = t - mod
+	MOVQ out_base+0(FP), R14
+	MOVQ DI, R12
+	SUBQ 0(AX), R12
+	MOVQ R12, 0(R14)
+	MOVQ R8, R12
+	SBBQ 8(AX), R12
+	MOVQ R12, 8(R14)
+	MOVQ BX, R12
+	SBBQ 16(AX), R12
+	MOVQ R12, 16(R14)
+	SBBQ $0, CX
+
+	// out = t if the subtraction borrowed
+	MOVQ 0(R14), R12
+	CMOVQCS DI, R12
+	MOVQ R12, 0(R14)
+	MOVQ 8(R14), R12
+	CMOVQCS R8, R12
+	MOVQ R12, 8(R14)
+	MOVQ 16(R14), R12
+	CMOVQCS BX, R12
+	MOVQ R12, 16(R14)
+	RET
+
+
+// func mulMontADX256(out, x, y, mod []uint64, modInv uint64)
+TEXT ·mulMontADX256(SB), NOSPLIT, $0-104
+	MOVQ x_base+24(FP), R14
+	MOVQ y_base+48(FP), R15
+	MOVQ mod_base+72(FP), AX
+	XORQ BX, BX
+	XORQ CX, CX
+	XORQ SI, SI
+	XORQ DI, DI
+	XORQ R8, R8
+	XORQ R9, R9
+
+	// t += x[0] * y
+	MOVQ 0(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MOVQ $0, R12
+	ADOXQ R12, R8
+	ADCXQ R12, R9
+	ADOXQ R12, R9
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ BX, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MOVQ $0, R12
+	ADOXQ R12, R8
+	ADCXQ R12, R9
+	ADOXQ R12, R9
+
+	// t += x[1] * y
+	MOVQ 8(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MOVQ $0, R12
+	ADOXQ R12, R9
+	ADCXQ R12, BX
+	ADOXQ R12, BX
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ CX, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MOVQ $0, R12
+	ADOXQ R12, R9
+	ADCXQ R12, BX
+	ADOXQ R12, BX
+
+	// t += x[2] * y
+	MOVQ 16(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, BX
+	MOVQ $0, R12
+	ADOXQ R12, BX
+	ADCXQ R12, CX
+	ADOXQ R12, CX
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ SI, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, BX
+	MOVQ $0, R12
+	ADOXQ R12, BX
+	ADCXQ R12, CX
+	ADOXQ R12, CX
+
+	// t += x[3] * y
+	MOVQ 24(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, BX
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MOVQ $0, R12
+	ADOXQ R12, CX
+	ADCXQ R12, SI
+	ADOXQ R12, SI
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ DI, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, BX
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MOVQ $0, R12
+	ADOXQ R12, CX
+	ADCXQ R12, SI
+	ADOXQ R12, SI
+
+	// out = t - mod
+	MOVQ out_base+0(FP), R14
+	MOVQ R8, R12
+	SUBQ 0(AX), R12
+	MOVQ R12, 0(R14)
+	MOVQ R9, R12
+	SBBQ 8(AX), R12
+	MOVQ R12, 8(R14)
+	MOVQ BX, R12
+	SBBQ 16(AX), R12
+	MOVQ R12, 16(R14)
+	MOVQ CX, R12
+	SBBQ 24(AX), R12
+	MOVQ R12, 24(R14)
+	SBBQ $0, SI
+
+	// out = t if the subtraction borrowed
+	MOVQ 0(R14), R12
+	CMOVQCS R8, R12
+	MOVQ R12, 0(R14)
+	MOVQ 8(R14), R12
+	CMOVQCS R9, R12
+	MOVQ R12, 8(R14)
+	MOVQ 16(R14), R12
+	CMOVQCS BX, R12
+	MOVQ R12, 16(R14)
+	MOVQ 24(R14), R12
+	CMOVQCS CX, R12
+	MOVQ R12, 24(R14)
+	RET
+
+
+// func mulMontADX320(out, x, y, mod []uint64, modInv uint64)
+TEXT ·mulMontADX320(SB), NOSPLIT, $0-104
+	MOVQ x_base+24(FP), R14
+	MOVQ y_base+48(FP), R15
+	MOVQ mod_base+72(FP), AX
+	XORQ BX, BX
+	XORQ CX, CX
+	XORQ SI, SI
+	XORQ DI, DI
+	XORQ R8, R8
+	XORQ R9, R9
+	XORQ R10, R10
+
+	// t += x[0] * y
+	MOVQ 0(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 32(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MOVQ $0, R12
+	ADOXQ R12, R9
+	ADCXQ R12, R10
+	ADOXQ R12, R10
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ BX, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 32(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MOVQ $0, R12
+	ADOXQ R12, R9
+	ADCXQ R12, R10
+	ADOXQ R12, R10
+
+	// t += x[1] * y
+	MOVQ 8(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 32(R15), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MOVQ $0, R12
+	ADOXQ R12, R10
+	ADCXQ R12, BX
+	ADOXQ R12, BX
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ CX, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 32(AX), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MOVQ $0, R12
+	ADOXQ R12, R10
+	ADCXQ R12, BX
+	ADOXQ R12, BX
+
+	// t += x[2] * y
+	MOVQ 16(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 32(R15), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, BX
+	MOVQ $0, R12
+	ADOXQ R12, BX
+	ADCXQ R12, CX
+	ADOXQ R12, CX
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ SI, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 32(AX), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, BX
+	MOVQ $0, R12
+	ADOXQ R12, BX
+	ADCXQ R12, CX
+	ADOXQ R12, CX
+
+	// t += x[3] * y
+	MOVQ 24(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, BX
+	MULXQ 32(R15), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MOVQ $0, R12
+	ADOXQ R12, CX
+	ADCXQ R12, SI
+	ADOXQ R12, SI
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ DI, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, BX
+	MULXQ 32(AX), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MOVQ $0, R12
+	ADOXQ R12, CX
+	ADCXQ R12, SI
+	ADOXQ R12, SI
+
+	// t += x[4] * y
+	MOVQ 32(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, BX
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 32(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MOVQ $0, R12
+	ADOXQ R12, SI
+	ADCXQ R12, DI
+	ADOXQ R12, DI
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ R8, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, BX
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 32(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MOVQ $0, R12
+	ADOXQ R12, SI
+	ADCXQ R12, DI
+	ADOXQ R12, DI
+
+	// out = t - mod
+	MOVQ out_base+0(FP), R14
+	MOVQ R9, R12
+	SUBQ 0(AX), R12
+	MOVQ R12, 0(R14)
+	MOVQ R10, R12
+	SBBQ 8(AX), R12
+	MOVQ R12, 8(R14)
+	MOVQ BX, R12
+	SBBQ 16(AX), R12
+	MOVQ R12, 16(R14)
+	MOVQ CX, R12
+	SBBQ 24(AX), R12
+	MOVQ R12, 24(R14)
+	MOVQ SI, R12
+	SBBQ 32(AX), R12
+	MOVQ R12, 32(R14)
+	SBBQ $0, DI
+
+	// out = t if the subtraction borrowed
+	MOVQ 0(R14), R12
+	CMOVQCS R9, R12
+	MOVQ R12, 0(R14)
+	MOVQ 8(R14), R12
+	CMOVQCS R10, R12
+	MOVQ R12, 8(R14)
+	MOVQ 16(R14), R12
+	CMOVQCS BX, R12
+	MOVQ R12, 16(R14)
+	MOVQ 24(R14), R12
+	CMOVQCS CX, R12
+	MOVQ R12, 24(R14)
+	MOVQ 32(R14), R12
+	CMOVQCS SI, R12
+	MOVQ R12, 32(R14)
+	RET
+
+
+// func mulMontADX384(out, x, y, mod []uint64, modInv uint64)
+TEXT ·mulMontADX384(SB), NOSPLIT, $0-104
+	MOVQ x_base+24(FP), R14
+	MOVQ y_base+48(FP), R15
+	MOVQ mod_base+72(FP), AX
+	XORQ BX, BX
+	XORQ CX, CX
+	XORQ SI, SI
+	XORQ DI, DI
+	XORQ R8, R8
+	XORQ R9, R9
+	XORQ R10, R10
+	XORQ R11, R11
+
+	// t += x[0] * y
+	MOVQ 0(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 32(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 40(R15), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MOVQ $0, R12
+	ADOXQ R12, R10
+	ADCXQ R12, R11
+	ADOXQ R12, R11
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ BX, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 32(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 40(AX), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MOVQ $0, R12
+	ADOXQ R12, R10
+	ADCXQ R12, R11
+	ADOXQ R12, R11
+
+	// t += x[1] * y
+	MOVQ 8(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 32(R15), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 40(R15), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, R11
+	MOVQ $0, R12
+	ADOXQ R12, R11
+	ADCXQ R12, BX
+	ADOXQ R12, BX
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ CX, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 32(AX), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 40(AX), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, R11
+	MOVQ $0, R12
+	ADOXQ R12, R11
+	ADCXQ R12, BX
+	ADOXQ R12, BX
+
+	// t += x[2] * y
+	MOVQ 16(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 32(R15), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, R11
+	MULXQ 40(R15), R12, R13
+	ADOXQ R12, R11
+	ADCXQ R13, BX
+	MOVQ $0, R12
+	ADOXQ R12, BX
+	ADCXQ R12, CX
+	ADOXQ R12, CX
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ SI, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 32(AX), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, R11
+	MULXQ 40(AX), R12, R13
+	ADOXQ R12, R11
+	ADCXQ R13, BX
+	MOVQ $0, R12
+	ADOXQ R12, BX
+	ADCXQ R12, CX
+	ADOXQ R12, CX
+
+	// t += x[3] * y
+	MOVQ 24(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, R11
+	MULXQ 32(R15), R12, R13
+	ADOXQ R12, R11
+	ADCXQ R13, BX
+	MULXQ 40(R15), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MOVQ $0, R12
+	ADOXQ R12, CX
+	ADCXQ R12, SI
+	ADOXQ R12, SI
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ DI, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, DI
+	ADCXQ R13, R8
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, R11
+	MULXQ 32(AX), R12, R13
+	ADOXQ R12, R11
+	ADCXQ R13, BX
+	MULXQ 40(AX), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MOVQ $0, R12
+	ADOXQ R12, CX
+	ADCXQ R12, SI
+	ADOXQ R12, SI
+
+	// t += x[4] * y
+	MOVQ 32(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, R11
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, R11
+	ADCXQ R13, BX
+	MULXQ 32(R15), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 40(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MOVQ $0, R12
+	ADOXQ R12, SI
+	ADCXQ R12, DI
+	ADOXQ R12, DI
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ R8, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, R8
+	ADCXQ R13, R9
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, R11
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, R11
+	ADCXQ R13, BX
+	MULXQ 32(AX), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 40(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MOVQ $0, R12
+	ADOXQ R12, SI
+	ADCXQ R12, DI
+	ADOXQ R12, DI
+
+	// t += x[5] * y
+	MOVQ 40(R14), DX
+	XORQ R12, R12
+	MULXQ 0(R15), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 8(R15), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, R11
+	MULXQ 16(R15), R12, R13
+	ADOXQ R12, R11
+	ADCXQ R13, BX
+	MULXQ 24(R15), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 32(R15), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 40(R15), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MOVQ $0, R12
+	ADOXQ R12, DI
+	ADCXQ R12, R8
+	ADOXQ R12, R8
+
+	// t += (t[0] * modInv % W) * mod, making t[0] zero
+	MOVQ R9, DX
+	IMULQ modInv+96(FP), DX
+	XORQ R12, R12
+	MULXQ 0(AX), R12, R13
+	ADOXQ R12, R9
+	ADCXQ R13, R10
+	MULXQ 8(AX), R12, R13
+	ADOXQ R12, R10
+	ADCXQ R13, R11
+	MULXQ 16(AX), R12, R13
+	ADOXQ R12, R11
+	ADCXQ R13, BX
+	MULXQ 24(AX), R12, R13
+	ADOXQ R12, BX
+	ADCXQ R13, CX
+	MULXQ 32(AX), R12, R13
+	ADOXQ R12, CX
+	ADCXQ R13, SI
+	MULXQ 40(AX), R12, R13
+	ADOXQ R12, SI
+	ADCXQ R13, DI
+	MOVQ $0, R12
+	ADOXQ R12, DI
+	ADCXQ R12, R8
+	ADOXQ R12, R8
+
+	// out = t - mod
+	MOVQ out_base+0(FP), R14
+	MOVQ R10, R12
+	SUBQ 0(AX), R12
+	MOVQ R12, 0(R14)
+	MOVQ R11, R12
+	SBBQ 8(AX), R12
+	MOVQ R12, 8(R14)
+	MOVQ BX, R12
+	SBBQ 16(AX), R12
+	MOVQ R12, 16(R14)
+	MOVQ CX, R12
+	SBBQ 24(AX), R12
+	MOVQ R12, 24(R14)
+	MOVQ SI, R12
+	SBBQ 32(AX), R12
+	MOVQ R12, 32(R14)
+	MOVQ DI, R12
+	SBBQ 40(AX), R12
+	MOVQ R12, 40(R14)
+	SBBQ $0, R8
+
+	// out = t if the subtraction borrowed
+	MOVQ 0(R14), R12
+	CMOVQCS R10, R12
+	MOVQ R12, 0(R14)
+	MOVQ 8(R14), R12
+	CMOVQCS R11, R12
+	MOVQ R12, 8(R14)
+	MOVQ 16(R14), R12
+	CMOVQCS BX, R12
+	MOVQ R12, 16(R14)
+	MOVQ 24(R14), R12
+	CMOVQCS CX, R12
+	MOVQ R12, 24(R14)
+	MOVQ 32(R14), R12
+	CMOVQCS SI, R12
+	MOVQ R12, 32(R14)
+	MOVQ 40(R14), R12
+	CMOVQCS DI, R12
+	MOVQ R12, 40(R14)
+	RET
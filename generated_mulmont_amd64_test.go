@@ -0,0 +1,57 @@
+// Code generated by the evmmax-arith generator. DO NOT EDIT.
+
+//go:build !purego
+
+package evmmax_arith
+
+import (
+	"testing"
+)
+
+func TestMulMontADX64(t *testing.T) {
+	checkGeneratedMulMontADX(t, mulMontADX64, 1)
+}
+
+func BenchmarkMulMontADX64(b *testing.B) {
+	benchmarkGeneratedMulMontADX(b, mulMontADX64, 1)
+}
+
+func TestMulMontADX128(t *testing.T) {
+	checkGeneratedMulMontADX(t, mulMontADX128, 2)
+}
+
+func BenchmarkMulMontADX128(b *testing.B) {
+	benchmarkGeneratedMulMontADX(b, mulMontADX128, 2)
+}
+
+func TestMulMontADX192(t *testing.T) {
+	checkGeneratedMulMontADX(t, mulMontADX192, 3)
+}
+
+func BenchmarkMulMontADX192(b *testing.B) {
+	benchmarkGeneratedMulMontADX(b, mulMontADX192, 3)
+}
+
+func TestMulMontADX256(t *testing.T) {
+	checkGeneratedMulMontADX(t, mulMontADX256, 4)
+}
+
+func BenchmarkMulMontADX256(b *testing.B) {
+	benchmarkGeneratedMulMontADX(b, mulMontADX256, 4)
+}
+
+func TestMulMontADX320(t *testing.T) {
+	checkGeneratedMulMontADX(t, mulMontADX320, 5)
+}
+
+func BenchmarkMulMontADX320(b *testing.B) {
+	benchmarkGeneratedMulMontADX(b, mulMontADX320, 5)
+}
+
+func TestMulMontADX384(t *testing.T) {
+	checkGeneratedMulMontADX(t, mulMontADX384, 6)
+}
+
+func BenchmarkMulMontADX384(b *testing.B) {
+	benchmarkGeneratedMulMontADX(b, mulMontADX384, 6)
+}
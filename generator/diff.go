@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change
+const diffContext = 3
+
+// maxDiffEdits bounds the work of the diff.  Files differing by more lines
+// are reported without a line diff.
+const maxDiffEdits = 4000
+
+type diffOp byte
+
+const (
+	diffEqual  diffOp = ' '
+	diffDelete diffOp = '-'
+	diffInsert diffOp = '+'
+)
+
+type diffLine struct {
+	op   diffOp
+	text string
+}
+
+func splitLines(src []byte) []string {
+	lines := strings.SplitAfter(string(src), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// myersDiff returns the shortest edit script turning a into b, or nil if it
+// has more than maxDiffEdits edits.
+func myersDiff(a, b []string) []diffLine {
+	n, m := len(a), len(b)
+	max := n + m
+	if max > maxDiffEdits {
+		max = maxDiffEdits
+	}
+	// v[k+max] is the furthest x reached on diagonal k = x - y
+	v := make([]int, 2*max+2)
+	var trace [][]int
+	for d := 0; d <= max; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[k-1+max] < v[k+1+max]) {
+				x = v[k+1+max]
+			} else {
+				x = v[k-1+max] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[k+max] = x
+			if x >= n && y >= m {
+				return backtrack(a, b, trace, max)
+			}
+		}
+	}
+	return nil
+}
+
+// backtrack recovers the edit script from the furthest reaching paths of
+// each edit distance.
+func backtrack(a, b []string, trace [][]int, max int) []diffLine {
+	var script []diffLine
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[k-1+max] < v[k+1+max]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[prevK+max]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			script = append(script, diffLine{diffEqual, a[x]})
+		}
+		if d > 0 {
+			if x == prevX {
+				y--
+				script = append(script, diffLine{diffInsert, b[y]})
+			} else {
+				x--
+				script = append(script, diffLine{diffDelete, a[x]})
+			}
+		}
+	}
+	for i, j := 0, len(script)-1; i < j; i, j = i+1, j-1 {
+		script[i], script[j] = script[j], script[i]
+	}
+	return script
+}
+
+// hunkEnd returns the end of the hunk containing the change at index i:
+// changes at most 2*diffContext unchanged lines apart share a hunk, which ends
+// diffContext unchanged lines after its last change.
+func hunkEnd(script []diffLine, i int) int {
+	last := i
+	for j := i + 1; j < len(script) && j-last <= 2*diffContext+1; j++ {
+		if script[j].op != diffEqual {
+			last = j
+		}
+	}
+	end := last + 1 + diffContext
+	if end > len(script) {
+		end = len(script)
+	}
+	return end
+}
+
+// unifiedDiff returns a unified diff from the file oldName with contents a to
+// newName with contents b, or an empty string if they are equal.
+func unifiedDiff(oldName, newName string, a, b []byte) string {
+	if bytes.Equal(a, b) {
+		return ""
+	}
+	buf := new(strings.Builder)
+	fmt.Fprintf(buf, "--- %s\n+++ %s\n", oldName, newName)
+	script := myersDiff(splitLines(a), splitLines(b))
+	if script == nil {
+		fmt.Fprintf(buf, "more than %d lines differ\n", maxDiffEdits)
+		return buf.String()
+	}
+
+	// emit hunks of changes at most 2*diffContext unchanged lines apart
+	oldLine, newLine := 1, 1
+	for i := 0; i < len(script); {
+		if script[i].op == diffEqual {
+			oldLine++
+			newLine++
+			i++
+			continue
+		}
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := hunkEnd(script, i)
+		hunkOld, hunkNew := oldLine-(i-start), newLine-(i-start)
+		var oldCount, newCount int
+		hunk := new(strings.Builder)
+		for _, l := range script[start:end] {
+			if l.op != diffInsert {
+				oldCount++
+			}
+			if l.op != diffDelete {
+				newCount++
+			}
+			text := l.text
+			if !strings.HasSuffix(text, "\n") {
+				text += "\n\\ No newline at end of file\n"
+			}
+			fmt.Fprintf(hunk, "%c%s", l.op, text)
+		}
+		fmt.Fprintf(buf, "@@ -%d,%d +%d,%d @@\n%s", hunkOld, oldCount, hunkNew, newCount, hunk)
+		for _, l := range script[i:end] {
+			if l.op != diffInsert {
+				oldLine++
+			}
+			if l.op != diffDelete {
+				newLine++
+			}
+		}
+		i = end
+	}
+	return buf.String()
+}
@@ -2,28 +2,66 @@ package main
 
 import (
 	"bytes"
+	"embed"
+	"errors"
+	"flag"
 	"fmt"
-	"io/ioutil"
+	"go/format"
 	"log"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"text/template"
-
-	"errors"
 )
 
+//go:embed templates/*.template
+var templateFS embed.FS
+
 type TemplateParams struct {
-	LimbCount int
-	LimbBits  int
+	LimbCount       int
+	LimbBits        int
+	LimbType        string // uint64 or uint32
+	NamePrefix      string // inserted in function names before the bit width
+	MaddSuffix      string // suffix of the madd helpers for the limb type
+	BuildConstraint string
+	Package         string
+	Presets         []Preset
+	Moduli          []FixedModulus
 }
 
-func loadTextFile(file_name string) string {
-	content, err := ioutil.ReadFile(file_name)
-	if err != nil {
-		panic(err)
+// limb32Constraint restricts the 32-bit limb family to little-endian
+// platforms, where it is used by default on 32-bit and wasm targets and can
+// be enabled with the limb32 build tag on 64-bit ones.
+const limb32Constraint = "386 || arm || mipsle || wasm || (limb32 && (amd64 || arm64 || loong64 || mips64le || ppc64le || riscv64))"
+
+// newTemplateParams returns the parameters for the configured limb size
+func newTemplateParams(cfg *config, limbCount int, presets []Preset) TemplateParams {
+	params := TemplateParams{
+		LimbCount: limbCount,
+		LimbBits:  cfg.limbBits,
+		LimbType:  "uint64",
+		Package:   cfg.pkg,
+		Presets:   presets,
+	}
+	if cfg.limbBits == 32 {
+		params.LimbType = "uint32"
+		params.NamePrefix = "32x"
+		params.MaddSuffix = "u32"
+		params.BuildConstraint = limb32Constraint
 	}
+	return params
+}
 
-	return string(content)
+// Preset describes a table of the generated functions for each limb count,
+// indexed by limb count - 1.
+type Preset struct {
+	Name string // name of the table
+	Type string // function type of the table elements
+	Func string // function name prefix, followed by the bit width
+	// Kind selects the hand-written checkGenerated<Kind> and
+	// benchmarkGenerated<Kind> helpers of the generated tests
+	Kind string
 }
 
 // from Bavard
@@ -79,149 +117,412 @@ var funcs = template.FuncMap{
 	"mulp1": func(val, v2 int) int {
 		return (val + 1) * v2
 	},
-	// returns "[x]uint64 {0, 0, 0, ......, 0}"
-	"makeZeroedLimbs": func(numLimbs int) string {
-		result := fmt.Sprintf("[%d]uint64 {", numLimbs)
+	// returns "[x]limbType {0, 0, 0, ......, 0}"
+	"makeZeroedLimbs": func(numLimbs int, limbType string) string {
+		result := fmt.Sprintf("[%d]%s {", numLimbs, limbType)
 		return result + strings.Repeat(" 0,", numLimbs-1) + " 0}"
 	},
 	"dict": dict,
+	// capitalizes the first letter of a function name, to derive test names
+	"title": func(name string) string {
+		return strings.ToUpper(name[:1]) + name[1:]
+	},
+	// returns the register holding limb j of the intermediate value in the
+	// amd64 Montgomery multiplication at outer loop iteration i
+	"tReg": func(i, j, limbCount int) string {
+		return amd64TRegs[(i+j)%(limbCount+2)]
+	},
 }
 
-func aggregate(values []string) string {
-	var sb strings.Builder
-	for _, v := range values {
-		sb.WriteString(v)
-	}
-	return sb.String()
+// amd64TRegs are the registers holding the intermediate value of the amd64
+// Montgomery multiplication, limiting it to len(amd64TRegs) - 2 limbs.
+var amd64TRegs = []string{"BX", "CX", "SI", "DI", "R8", "R9", "R10", "R11"}
+
+// family is a group of generated functions emitted into one file: the body
+// template instantiated for each limb count, preceded by a header declaring
+// the preset tables.  Families which support 32-bit limbs emit them into
+// file32 with the presets32 tables.
+type family struct {
+	file      string
+	template  string
+	presets   []Preset
+	file32    string
+	presets32 []Preset
 }
 
-func buildTemplate(dest_path, template_path string, params *TemplateParams) {
-	f, err := os.Create(dest_path)
-	if err != nil {
-		log.Println("create file: ", err)
-		panic("")
-	}
-
-	template_content := loadTextFile(template_path)
-
-	tmpl := template.Must(template.New("").Funcs(funcs).Parse(template_content))
-
-	if err := tmpl.Execute(f, *params); err != nil {
-		log.Fatal(err)
-		panic("")
-	}
+var families = map[string]family{
+	"mulmont": {file: "mulmont-generated.go", template: "mulmont.go.template", presets: []Preset{
+		{"mulmodPreset", "mulFunc", "MontMul", "MontMul"},
+	}, file32: "generated_mulmont_limb32.go", presets32: []Preset{
+		{"mulmod32Preset", "mulFunc32", "MontMul", "MontMul32"},
+	}},
+	"addmod": {file: "generated_addmod_unrolled.go", template: "addmod_unrolled.go.template", presets: []Preset{
+		{"addmodPreset", "addOrSubFunc", "AddMod", "AddMod"},
+	}, file32: "generated_addmod_limb32.go", presets32: []Preset{
+		{"addmod32Preset", "addOrSubFunc32", "AddMod", "AddMod32"},
+	}},
+	"submod": {file: "generated_submod_unrolled.go", template: "submod_unrolled.go.template", presets: []Preset{
+		{"submodPreset", "addOrSubFunc", "SubMod", "SubMod"},
+	}, file32: "generated_submod_limb32.go", presets32: []Preset{
+		{"submod32Preset", "addOrSubFunc32", "SubMod", "SubMod32"},
+	}},
+	"binary": {file: "generated_binary_unrolled.go", template: "binary_unrolled.go.template", presets: []Preset{
+		{"mulmodBinaryPreset", "mulFunc", "MulModBinary", "MulModBinary"},
+		{"addmodBinaryPreset", "addOrSubFunc", "AddModBinary", "AddModBinary"},
+		{"submodBinaryPreset", "addOrSubFunc", "SubModBinary", "SubModBinary"},
+	}},
+	"sqrmont": {file: "generated_sqrmont.go", template: "sqrmont.go.template", presets: []Preset{
+		{"sqrmodPreset", "sqrFunc", "MontSqr", "MontSqr"},
+	}},
+	"fios": {file: "generated_mulmont_fios.go", template: "mulmont_fios.go.template", presets: []Preset{
+		{"mulmodFIOSPreset", "mulFunc", "MontMulFIOS", "MontMul"},
+	}},
+	"sos": {file: "generated_mulmont_sos.go", template: "mulmont_sos.go.template", presets: []Preset{
+		{"mulmodSOSPreset", "mulFunc", "MontMulSOS", "MontMul"},
+	}},
+	"nocarry": {file: "generated_mulmont_nocarry.go", template: "mulmont_nocarry.go.template", presets: []Preset{
+		{"mulmodNoCarryPreset", "mulFunc", "MontMulNoCarry", "MontMulNoCarry"},
+	}},
+	"pmersenne": {file: "generated_mulmod_pmersenne.go", template: "pmersenne.go.template", presets: []Preset{
+		{"mulmodPseudoMersennePreset", "pseudoMersenneMulFunc", "MulModPseudoMersenne", "MulModPseudoMersenne"},
+	}},
+	"barrett": {file: "generated_mulmod_barrett.go", template: "barrett.go.template", presets: []Preset{
+		{"mulmodBarrettPreset", "barrettMulFunc", "MulModBarrett", "MulModBarrett"},
+	}},
+}
 
-	f.Close()
+// opAmd64 is the op family of the amd64 assembly Montgomery multiplication,
+// which is generated up to its own maximum limb count.
+const opAmd64 = "amd64"
+
+// opFixed is the op family of the arithmetic specialized for the named
+// moduli selected with the -moduli flag.
+const opFixed = "fixed"
+
+// config holds the command-line parameters of the generator
+type config struct {
+	maxLimbs    int
+	asmMaxLimbs int
+	limbBits    int
+	outDir      string
+	pkg         string
+	ops         []string
+	moduli      []FixedModulus
+	check       bool
+	tests       bool
 }
-func genMulMont(maxLimbs int) {
-	headerTemplateContent := loadTextFile("templates/mulmontheader.go.template")
-	headerTemplate := template.Must(template.New("").Funcs(funcs).Parse(headerTemplateContent))
 
-	params := TemplateParams{maxLimbs, 64}
-	buf := new(bytes.Buffer)
+func parseTemplate(name string) (*template.Template, error) {
+	return template.New(name).Funcs(funcs).ParseFS(templateFS, "templates/"+name)
+}
 
-	f, err := os.Create("mulmont-generated.go")
+// renderFamily renders the header followed by the body template for each
+// limb count up to params.LimbCount.
+func renderFamily(headerName, bodyName string, params TemplateParams) ([]byte, error) {
+	header, err := parseTemplate(headerName)
 	if err != nil {
-		log.Fatal(err)
-		panic("")
+		return nil, err
 	}
-
-	if err := headerTemplate.Execute(buf, params); err != nil {
-		log.Fatal(err)
-		panic("")
+	body, err := parseTemplate(bodyName)
+	if err != nil {
+		return nil, err
 	}
 
-	mulMontTemplateContent := loadTextFile("templates/mulmont.go.template")
-	mulMontTemplate := template.Must(template.New("").Funcs(funcs).Parse(mulMontTemplateContent))
-
+	buf := new(bytes.Buffer)
+	if err := header.Execute(buf, params); err != nil {
+		return nil, err
+	}
+	maxLimbs := params.LimbCount
 	for i := 1; i <= maxLimbs; i++ {
-		params = TemplateParams{i, 64}
-		if err := mulMontTemplate.Execute(buf, params); err != nil {
-			log.Fatal(err)
-			panic("")
+		params.LimbCount = i
+		if err := body.Execute(buf, params); err != nil {
+			return nil, err
 		}
 	}
+	return buf.Bytes(), nil
+}
 
-	if n, err := f.Write(buf.Bytes()); err != nil || n != len(buf.Bytes()) {
-		panic(err)
+// renderAmd64 renders Montgomery multiplication in amd64 assembly and the Go
+// declarations of the generated functions.
+func renderAmd64(cfg *config, files map[string][]byte) error {
+	if cfg.asmMaxLimbs > len(amd64TRegs)-2 {
+		return fmt.Errorf("amd64 Montgomery multiplication supports at most %d limbs", len(amd64TRegs)-2)
 	}
-}
-func genAddMod(addModType string, maxLimbs int) {
-	headerTemplateContent := loadTextFile("templates/addmodsubmodheader.go.template")
-	headerTemplate := template.Must(template.New("").Funcs(funcs).Parse(headerTemplateContent))
+	asm, err := renderFamily("mulmont_amd64header.s.template", "mulmont_amd64.s.template", newTemplateParams(cfg, cfg.asmMaxLimbs, nil))
+	if err != nil {
+		return err
+	}
+	files["generated_mulmont_amd64.s"] = asm
 
-	// note: hack of using LimbCount to store maxLimbs here
-	params := TemplateParams{maxLimbs, 64}
+	decls, err := parseTemplate("mulmont_amd64.go.template")
+	if err != nil {
+		return err
+	}
 	buf := new(bytes.Buffer)
+	if err := decls.Execute(buf, newTemplateParams(cfg, cfg.asmMaxLimbs, nil)); err != nil {
+		return err
+	}
+	files["generated_mulmont_amd64.go"] = buf.Bytes()
+
+	if cfg.tests {
+		params := newTemplateParams(cfg, cfg.asmMaxLimbs, []Preset{
+			{"mulmodADXPreset", "mulFunc", "mulMontADX", "MulMontADX"},
+		})
+		params.BuildConstraint = "!purego"
+		tests, err := renderFamily("testsheader.go.template", "tests.go.template", params)
+		if err != nil {
+			return err
+		}
+		files["generated_mulmont_amd64_test.go"] = tests
+	}
+	return nil
+}
 
-	f, err := os.Create(fmt.Sprintf("generated_addmod_%s.go", addModType))
+// renderFixed renders the arithmetic specialized for the selected named
+// moduli and their registry.
+func renderFixed(cfg *config, files map[string][]byte) error {
+	tmpl, err := parseTemplate("fixed.go.template")
 	if err != nil {
-		log.Fatal(err)
-		panic("")
+		return err
 	}
+	params := newTemplateParams(cfg, 0, nil)
+	params.Moduli = cfg.moduli
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, params); err != nil {
+		return err
+	}
+	files["generated_fixed_moduli.go"] = buf.Bytes()
+	return nil
+}
 
-	if err := headerTemplate.Execute(buf, params); err != nil {
-		log.Fatal(err)
-		panic("")
+// render renders the selected op families into memory, returning the
+// contents of each generated file by name.  Unless disabled, a test file
+// exercises every generated function of each limb size.  Go sources are
+// gofmt'd.
+func render(cfg *config) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	var tested []Preset
+	for _, op := range cfg.ops {
+		if op == opAmd64 {
+			if err := renderAmd64(cfg, files); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if op == opFixed {
+			if err := renderFixed(cfg, files); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		fam := families[op]
+		file, presets := fam.file, fam.presets
+		if cfg.limbBits == 32 {
+			file, presets = fam.file32, fam.presets32
+		}
+		src, err := renderFamily("header.go.template", fam.template, newTemplateParams(cfg, cfg.maxLimbs, presets))
+		if err != nil {
+			return nil, err
+		}
+		files[file] = src
+		tested = append(tested, presets...)
+	}
+	if cfg.tests && len(tested) != 0 {
+		file := testsFile(cfg.limbBits)
+		src, err := renderFamily("testsheader.go.template", "tests.go.template", newTemplateParams(cfg, cfg.maxLimbs, tested))
+		if err != nil {
+			return nil, err
+		}
+		files[file] = src
 	}
 
-	addModTemplateContent := loadTextFile(fmt.Sprintf("templates/addmod_%s.go.template", addModType))
-	addModTemplate := template.Must(template.New("").Funcs(funcs).Parse(addModTemplateContent))
+	for name, src := range files {
+		if filepath.Ext(name) != ".go" {
+			continue
+		}
+		formatted, err := format.Source(src)
+		if err != nil {
+			return nil, fmt.Errorf("formatting %s: %w", name, err)
+		}
+		files[name] = formatted
+	}
+	return files, nil
+}
 
-	// TODO account for the implementations at 1 limb
-	for i := 1; i <= maxLimbs; i++ {
-		params = TemplateParams{i, 64}
-		if err := addModTemplate.Execute(buf, params); err != nil {
-			log.Fatal(err)
-			panic("")
+// opNames returns the names of the op families supporting the given limb
+// size in a stable order
+func opNames(limbBits int) []string {
+	var names []string
+	if limbBits == 64 {
+		names = append(names, opAmd64, opFixed)
+	}
+	for name, fam := range families {
+		if limbBits == 64 || fam.file32 != "" {
+			names = append(names, name)
 		}
 	}
+	sort.Strings(names)
+	return names
+}
 
-	if n, err := f.Write(buf.Bytes()); err != nil || n != len(buf.Bytes()) {
-		panic(err)
+// parseOps parses a comma-separated list of op families, where "all" selects
+// every family supporting the limb size.
+func parseOps(list string, limbBits int) ([]string, error) {
+	supported := opNames(limbBits)
+	if list == "all" {
+		return supported, nil
+	}
+	var ops []string
+	for _, op := range strings.Split(list, ",") {
+		i := sort.SearchStrings(supported, op)
+		if i == len(supported) || supported[i] != op {
+			return nil, fmt.Errorf("unknown op family %q for %d-bit limbs, expected one of %s", op, limbBits, strings.Join(supported, ", "))
+		}
+		ops = append(ops, op)
 	}
+	return ops, nil
 }
 
-// TODO merge genSubMod/genAddMod into same function
-func genSubMod(subModType string, maxLimbs int) {
-	headerTemplateContent := loadTextFile("templates/addmodsubmodheader.go.template")
-	headerTemplate := template.Must(template.New("").Funcs(funcs).Parse(headerTemplateContent))
+// generatedFilePrefix prefixes the names of the generated files, except the
+// historical mulmont-generated.go
+const generatedFilePrefix = "generated_"
 
-	params := TemplateParams{0, 64}
-	buf := new(bytes.Buffer)
+// limb32FileMarker marks the names of the files generated with 32-bit limbs,
+// which share the output directory with the 64-bit ones
+const limb32FileMarker = "_limb32"
 
-	f, err := os.Create(fmt.Sprintf("generated_submod_%s.go", subModType))
-	if err != nil {
-		log.Fatal(err)
-		panic("")
+// testsFile returns the name of the file holding the tests of the op
+// families generated for the given limb size
+func testsFile(limbBits int) string {
+	if limbBits == 32 {
+		return "generated_arith_limb32_test.go"
 	}
+	return "generated_arith_test.go"
+}
 
-	if err := headerTemplate.Execute(buf, params); err != nil {
-		log.Fatal(err)
-		panic("")
+// allOps reports whether every op family supporting the limb size is
+// selected.
+func (cfg *config) allOps() bool {
+	selected := make(map[string]bool, len(cfg.ops))
+	for _, op := range cfg.ops {
+		selected[op] = true
+	}
+	for _, op := range opNames(cfg.limbBits) {
+		if !selected[op] {
+			return false
+		}
 	}
+	return true
+}
 
-	subModTemplateContent := loadTextFile(fmt.Sprintf("templates/submod_%s.go.template", subModType))
-	subModTemplate := template.Must(template.New("").Funcs(funcs).Parse(subModTemplateContent))
+// checkFiles compares the rendered files against the ones in the output
+// directory, returning an error holding a diff of each stale or missing file.
+// If only some op families are selected, the tests file, which covers every
+// family, is not compared.  If all op families and their tests are selected,
+// generated files of the run's limb size which it did not produce, left over
+// from a removed width or op family, are reported as stale.
+func checkFiles(cfg *config, files map[string][]byte) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		if name == testsFile(cfg.limbBits) && !cfg.allOps() {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
-	fmt.Println("submod loop")
-	for i := 1; i <= maxLimbs; i++ {
-		fmt.Println("iteration")
-		params = TemplateParams{i, 64}
-		if err := subModTemplate.Execute(buf, params); err != nil {
-			log.Fatal(err)
-			panic("")
+	var diffs []string
+	if cfg.allOps() && cfg.tests {
+		entries, err := os.ReadDir(cfg.outDir)
+		if err != nil {
+			return err
 		}
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || !strings.HasPrefix(name, generatedFilePrefix) || files[name] != nil {
+				continue
+			}
+			if strings.Contains(name, limb32FileMarker) != (cfg.limbBits == 32) {
+				// generated by the run for the other limb size
+				continue
+			}
+			diffs = append(diffs, fmt.Sprintf("%s is not generated anymore\n", filepath.Join(cfg.outDir, name)))
+		}
+	}
+	for _, name := range names {
+		path := filepath.Join(cfg.outDir, name)
+		committed, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			diffs = append(diffs, fmt.Sprintf("%s is missing\n", path))
+			continue
+		} else if err != nil {
+			return err
+		}
+		if diff := unifiedDiff(path, path+" (generated)", committed, files[name]); diff != "" {
+			diffs = append(diffs, diff)
+		}
+	}
+	if len(diffs) != 0 {
+		return fmt.Errorf("generated files are out of date, run go generate:\n%s", strings.Join(diffs, ""))
 	}
+	return nil
+}
 
-	if n, err := f.Write(buf.Bytes()); err != nil || n != len(buf.Bytes()) {
-		panic(err)
+func parseFlags(args []string) (*config, error) {
+	fs := flag.NewFlagSet("generator", flag.ContinueOnError)
+	cfg := &config{}
+	var ops, moduli string
+	fs.IntVar(&cfg.maxLimbs, "max-limbs", 12, "generate functions for moduli of up to this many limbs")
+	fs.IntVar(&cfg.asmMaxLimbs, "asm-max-limbs", 6, "generate amd64 assembly for moduli of up to this many limbs")
+	fs.IntVar(&cfg.limbBits, "limb-bits", 64, "size of limbs in bits")
+	fs.StringVar(&cfg.outDir, "out", ".", "output directory")
+	fs.StringVar(&cfg.pkg, "package", "evmmax_arith", "package name of the generated code")
+	fs.StringVar(&moduli, "moduli", "all", "comma-separated named moduli to generate specialized arithmetic for: all, none or any of "+strings.Join(moduliKeys(), ","))
+	fs.BoolVar(&cfg.tests, "tests", true, "also generate tests and benchmarks of each generated function, which use the package's hand-written test helpers")
+	fs.BoolVar(&cfg.check, "check", false, "compare the generated code against the files in the output directory instead of writing them, also reporting generated files of the limb size which are not produced when generating all op families and tests")
+	fs.StringVar(&ops, "ops", "all", "comma-separated op families to generate: all or any of "+strings.Join(opNames(64), ","))
+	if err := fs.Parse(args); err != nil {
+		return nil, err
 	}
+	if fs.NArg() != 0 {
+		return nil, fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+	if cfg.maxLimbs < 1 || cfg.asmMaxLimbs < 1 {
+		return nil, errors.New("limb counts must be positive")
+	}
+	if cfg.limbBits != 64 && cfg.limbBits != 32 {
+		return nil, fmt.Errorf("unsupported limb size %d", cfg.limbBits)
+	}
+	var err error
+	if cfg.ops, err = parseOps(ops, cfg.limbBits); err != nil {
+		return nil, err
+	}
+	if cfg.moduli, err = parseModuli(moduli); err != nil {
+		return nil, err
+	}
+	return cfg, nil
 }
 
 func main() {
-	maxLimbs := 12
-	genMulMont(maxLimbs)
-	genAddMod("unrolled", 12)
-	genSubMod("unrolled", 12)
+	log.SetFlags(0)
+	log.SetPrefix("generator: ")
+	cfg, err := parseFlags(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+	files, err := render(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if cfg.check {
+		if err := checkFiles(cfg, files); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(cfg.outDir, name), src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
 }
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const generateDirective = "//go:generate go run ./generator "
+
+// TestGeneratedFilesUpToDate renders the code of every go:generate directive
+// of the package and compares it against the committed files.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	src, err := os.ReadFile("../generate.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var directives int
+	for _, line := range strings.Split(string(src), "\n") {
+		args, ok := strings.CutPrefix(line, generateDirective)
+		if !ok {
+			continue
+		}
+		directives++
+		cfg, err := parseFlags(strings.Fields(args))
+		if err != nil {
+			t.Fatalf("%s: %v", line, err)
+		}
+		// directives run in the package directory, the test in the generator's
+		cfg.outDir = filepath.Join("..", cfg.outDir)
+		files, err := render(cfg)
+		if err != nil {
+			t.Fatalf("%s: %v", line, err)
+		}
+		if err := checkFiles(cfg, files); err != nil {
+			t.Errorf("%s: %v", line, err)
+		}
+	}
+	if directives == 0 {
+		t.Fatal("no generator directives found in generate.go")
+	}
+}
+
+// TestCheckFilesStale checks that generated files left over in the output
+// directory are reported, but not those of the other limb size.
+// writeRendered renders the files selected by args into dir
+func writeRendered(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cfg, err := parseFlags(append([]string{"-out", dir, "-max-limbs", "2", "-asm-max-limbs", "2"}, args...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := render(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), src, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// checkDir runs the check mode selected by args against dir
+func checkDir(t *testing.T, dir string, args ...string) error {
+	t.Helper()
+	cfg, err := parseFlags(append([]string{"-out", dir, "-max-limbs", "2", "-asm-max-limbs", "2", "-check"}, args...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := render(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return checkFiles(cfg, files)
+}
+
+func TestCheckFilesStale(t *testing.T) {
+	dir := t.TempDir()
+	writeRendered(t, dir)
+	for _, name := range []string{"generated_addmod_limb32.go", "README.md"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := checkDir(t, dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "generated_removed_unrolled.go"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	err := checkDir(t, dir)
+	if err == nil || !strings.Contains(err.Error(), "generated_removed_unrolled.go is not generated anymore") {
+		t.Fatalf("expected the stale file to be reported, got %v", err)
+	}
+}
+
+// TestCheckFilesSubset checks that a run selecting some op families accepts
+// the files of the other families and the tests file covering all of them.
+func TestCheckFilesSubset(t *testing.T) {
+	dir := t.TempDir()
+	writeRendered(t, dir)
+	for _, args := range [][]string{
+		{"-ops", "addmod,submod,mulmont"},
+		{"-ops", "addmod", "-tests=false"},
+	} {
+		if err := checkDir(t, dir, args...); err != nil {
+			t.Fatalf("%v: unexpected error: %v", args, err)
+		}
+	}
+
+	// files of the selected families are still compared
+	if err := os.WriteFile(filepath.Join(dir, "generated_addmod_unrolled.go"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkDir(t, dir, "-ops", "addmod"); err == nil {
+		t.Fatal("expected the modified file to be reported")
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	a := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\n"
+	b := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\n"
+	expected := `--- old
++++ new
+@@ -1,5 +1,5 @@
+ a
+-b
++B
+ c
+ d
+ e
+@@ -10,3 +10,4 @@
+ j
+ k
+ l
++m
+`
+	if diff := unifiedDiff("old", "new", []byte(a), []byte(b)); diff != expected {
+		t.Fatalf("unexpected diff:\n%s", diff)
+	}
+	if diff := unifiedDiff("old", "new", []byte(a), []byte(a)); diff != "" {
+		t.Fatalf("expected no diff for equal files, got:\n%s", diff)
+	}
+}
+
+func TestParseModuli(t *testing.T) {
+	moduli, err := parseModuli("secp256k1-fp,goldilocks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(moduli) != 2 || moduli[0].Ident != "Secp256k1Fp" || moduli[1].Ident != "Goldilocks" {
+		t.Fatalf("unexpected moduli %+v", moduli)
+	}
+	// the top limb of secp256k1's modulus leaves no spare bit
+	if moduli[0].LimbCount != 4 || moduli[0].NoCarry {
+		t.Fatalf("unexpected parameters for secp256k1: %+v", moduli[0])
+	}
+	if moduli[1].ModInv != "0xfffffffeffffffff" {
+		t.Fatalf("unexpected Goldilocks modulus inverse %s", moduli[1].ModInv)
+	}
+
+	if moduli, err := parseModuli("none"); err != nil || len(moduli) != 0 {
+		t.Fatalf("expected no moduli, got %v, %v", moduli, err)
+	}
+	if _, err := parseModuli("bn254-fp,p-521"); err == nil {
+		t.Fatal("expected an error for an unknown modulus")
+	}
+}
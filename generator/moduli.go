@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// namedModulus is a well-known modulus for which specialized arithmetic with
+// the modulus baked in as constants can be generated.
+type namedModulus struct {
+	Key   string // name selecting the modulus with the -moduli flag
+	Ident string // suffix of the generated function names
+	Desc  string // description used in doc comments
+	Hex   string // the modulus, big-endian hex
+}
+
+var namedModuli = []namedModulus{
+	{"bn254-fp", "BN254Fp", "the BN254 base field", "30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47"},
+	{"bn254-fr", "BN254Fr", "the BN254 scalar field", "30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000001"},
+	{"bls12-381-fp", "BLS12381Fp", "the BLS12-381 base field", "1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab"},
+	{"bls12-381-fr", "BLS12381Fr", "the BLS12-381 scalar field", "73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001"},
+	{"secp256k1-fp", "Secp256k1Fp", "the secp256k1 base field", "fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"},
+	{"p256-fp", "P256Fp", "the NIST P-256 base field", "ffffffff00000001000000000000000000000000ffffffffffffffffffffffff"},
+	{"goldilocks", "Goldilocks", "the Goldilocks field 2**64 - 2**32 + 1", "ffffffff00000001"},
+}
+
+// FixedModulus holds the template parameters of a named modulus
+type FixedModulus struct {
+	namedModulus
+	ConstPrefix string   // prefix of the generated constants
+	Limbs       []string // little-endian limbs in hex
+	ModInv      string   // -mod**-1 % 2**64 in hex
+	LimbCount   int
+	// NoCarry is true if the top limb of the modulus leaves the spare bit
+	// required by the no-carry CIOS variant
+	NoCarry bool
+}
+
+func newFixedModulus(named namedModulus) (FixedModulus, error) {
+	mod, ok := new(big.Int).SetString(named.Hex, 16)
+	if !ok || mod.Bit(0) != 1 {
+		return FixedModulus{}, fmt.Errorf("invalid modulus %s: must be odd", named.Key)
+	}
+	w := new(big.Int).Lsh(big.NewInt(1), 64)
+	modInv := new(big.Int).ModInverse(mod, w)
+	modInv.Sub(w, modInv)
+
+	fixed := FixedModulus{
+		namedModulus: named,
+		ConstPrefix:  "fixed" + named.Ident,
+		ModInv:       fmt.Sprintf("0x%016x", modInv.Uint64()),
+	}
+	words := new(big.Int).Set(mod)
+	mask := new(big.Int).Sub(w, big.NewInt(1))
+	var top uint64
+	for words.Sign() != 0 {
+		top = new(big.Int).And(words, mask).Uint64()
+		fixed.Limbs = append(fixed.Limbs, fmt.Sprintf("0x%016x", top))
+		words.Rsh(words, 64)
+	}
+	fixed.LimbCount = len(fixed.Limbs)
+	fixed.NoCarry = top < 1<<63-1
+	return fixed, nil
+}
+
+// moduliKeys returns the keys of the named moduli in a stable order
+func moduliKeys() []string {
+	var keys []string
+	for _, named := range namedModuli {
+		keys = append(keys, named.Key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// parseModuli parses a comma-separated list of named moduli, where "all"
+// selects every one and "none" disables their generation.
+func parseModuli(list string) ([]FixedModulus, error) {
+	if list == "none" {
+		return nil, nil
+	}
+	selected := make(map[string]bool)
+	if list != "all" {
+		for _, key := range strings.Split(list, ",") {
+			selected[key] = true
+		}
+	}
+	var moduli []FixedModulus
+	for _, named := range namedModuli {
+		if list != "all" && !selected[named.Key] {
+			continue
+		}
+		delete(selected, named.Key)
+		fixed, err := newFixedModulus(named)
+		if err != nil {
+			return nil, err
+		}
+		moduli = append(moduli, fixed)
+	}
+	for key := range selected {
+		return nil, fmt.Errorf("unknown modulus %q, expected one of %s", key, strings.Join(moduliKeys(), ", "))
+	}
+	return moduli, nil
+}
@@ -0,0 +1,295 @@
+// Package interpreter executes EVMMAX bytecode (SETMODX, STOREX, LOADX,
+// ADDMODX, SUBMODX and MULMODX) against a field context and a byte-addressed
+// memory.
+package interpreter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	evmmax "github.com/jwasinger/evmmax-arith"
+)
+
+const (
+	// maxStackDepth is the maximum number of items on the stack
+	maxStackDepth = 1024
+	// maxMemorySize bounds memory expansion well below the point where the
+	// expansion cost would overflow
+	maxMemorySize = math.MaxUint32
+
+	// gas cost of PUSH0 (EIP-3855) and of the PUSH opcodes with an immediate
+	push0Cost = 2
+	pushCost  = 3
+	// linear and quadratic coefficients of memory expansion cost
+	memoryCostPerWord  = 3
+	memoryQuadCoeffDiv = 512
+)
+
+var (
+	ErrOutOfGas           = errors.New("out of gas")
+	ErrStackUnderflow     = errors.New("stack underflow")
+	ErrStackOverflow      = errors.New("stack overflow")
+	ErrInvalidOpcode      = errors.New("invalid opcode")
+	ErrTruncatedImmediate = errors.New("truncated immediate")
+	ErrModulusNotSet      = errors.New("arithmetic performed before SETMODX")
+	ErrValueOverflow      = errors.New("stack value out of range")
+)
+
+// Interpreter executes EVMMAX bytecode.  The field context is instantiated by
+// SETMODX and persists across calls to Run.
+type Interpreter struct {
+	// Gas is the amount of gas remaining
+	Gas uint64
+	// Memory is the byte-addressed memory read by SETMODX/STOREX and written
+	// by LOADX.  It is expanded (and expansion charged for) as needed.
+	Memory []byte
+
+	stack    []uint64
+	fieldCtx *evmmax.FieldContext
+}
+
+// New returns an interpreter with empty memory and stack and the given gas.
+func New(gas uint64) *Interpreter {
+	return &Interpreter{Gas: gas}
+}
+
+// Stack returns the stack contents, with the top of the stack last.
+func (in *Interpreter) Stack() []uint64 {
+	return in.stack
+}
+
+// FieldContext returns the field context set by the last SETMODX, or nil.
+func (in *Interpreter) FieldContext() *evmmax.FieldContext {
+	return in.fieldCtx
+}
+
+// Run executes code until a STOP, the end of the code, or an error.  On error
+// the interpreter state reflects execution up to the failing instruction.
+func (in *Interpreter) Run(code []byte) error {
+	for pc := 0; pc < len(code); {
+		op := OpCode(code[pc])
+		immSize := op.immediateSize()
+		if pc+1+immSize > len(code) {
+			return fmt.Errorf("%v at pc %d: %w", op, pc, ErrTruncatedImmediate)
+		}
+		imm := code[pc+1 : pc+1+immSize]
+
+		var err error
+		switch {
+		case op == STOP:
+			return nil
+		case op == PUSH0:
+			err = in.opPush(push0Cost, imm)
+		case op.IsPush():
+			err = in.opPush(pushCost, imm)
+		case op == SETMODX:
+			err = in.opSetmod()
+		case op == STOREX:
+			err = in.opStore()
+		case op == LOADX:
+			err = in.opLoad()
+		case op == ADDMODX || op == SUBMODX || op == MULMODX:
+			err = in.opArith(op, imm)
+		default:
+			err = ErrInvalidOpcode
+		}
+		if err != nil {
+			return fmt.Errorf("%v at pc %d: %w", op, pc, err)
+		}
+		pc += 1 + immSize
+	}
+	return nil
+}
+
+// useGas deducts the given amount of gas, returning ErrOutOfGas if there is
+// insufficient gas remaining.
+func (in *Interpreter) useGas(amount uint64) error {
+	if in.Gas < amount {
+		in.Gas = 0
+		return ErrOutOfGas
+	}
+	in.Gas -= amount
+	return nil
+}
+
+func (in *Interpreter) push(val uint64) error {
+	if len(in.stack) >= maxStackDepth {
+		return ErrStackOverflow
+	}
+	in.stack = append(in.stack, val)
+	return nil
+}
+
+// pop removes n items from the stack, returning them top of the stack first.
+func (in *Interpreter) pop(n int) ([]uint64, error) {
+	if len(in.stack) < n {
+		return nil, ErrStackUnderflow
+	}
+	vals := make([]uint64, n)
+	for i := 0; i < n; i++ {
+		vals[i] = in.stack[len(in.stack)-1-i]
+	}
+	in.stack = in.stack[:len(in.stack)-n]
+	return vals, nil
+}
+
+// memoryCost returns the total cost of a memory of the given size in bytes.
+func memoryCost(size uint64) uint64 {
+	words := (size + 31) / 32
+	return words*memoryCostPerWord + words*words/memoryQuadCoeffDiv
+}
+
+// memory returns the memory region [offset, offset+size), expanding memory and
+// charging for the expansion if necessary.
+func (in *Interpreter) memory(offset, size uint64) ([]byte, error) {
+	if size == 0 {
+		return nil, nil
+	}
+	end, carry := bits.Add64(offset, size, 0)
+	if carry != 0 || end > maxMemorySize {
+		return nil, ErrOutOfGas
+	}
+	if end > uint64(len(in.Memory)) {
+		newSize := (end + 31) / 32 * 32
+		if err := in.useGas(memoryCost(newSize) - memoryCost(uint64(len(in.Memory)))); err != nil {
+			return nil, err
+		}
+		in.Memory = append(in.Memory, make([]byte, newSize-uint64(len(in.Memory)))...)
+	}
+	return in.Memory[offset:end], nil
+}
+
+func (in *Interpreter) opPush(cost uint64, imm []byte) error {
+	if err := in.useGas(cost); err != nil {
+		return err
+	}
+	var val uint64
+	for i, b := range imm {
+		if len(imm)-i > 8 {
+			if b != 0 {
+				return ErrValueOverflow
+			}
+			continue
+		}
+		val = val<<8 | uint64(b)
+	}
+	return in.push(val)
+}
+
+// opSetmod pops modOffset, modSize and allocCount, and instantiates a field
+// context from the big-endian modulus in memory[modOffset:modOffset+modSize].
+func (in *Interpreter) opSetmod() error {
+	args, err := in.pop(3)
+	if err != nil {
+		return err
+	}
+	modOffset, modSize, allocCount := args[0], args[1], args[2]
+	if err := in.useGas(evmmax.SetModCost(modSize, allocCount)); err != nil {
+		return err
+	}
+	modBytes, err := in.memory(modOffset, modSize)
+	if err != nil {
+		return err
+	}
+	if allocCount > math.MaxInt32 {
+		return ErrValueOverflow
+	}
+	fieldCtx, err := evmmax.NewFieldContext(modBytes, int(allocCount))
+	if err != nil {
+		return err
+	}
+	in.fieldCtx = fieldCtx
+	return nil
+}
+
+// elemRange validates a range of count field elements and returns the size in
+// bytes of the range.
+func (in *Interpreter) elemRange(operand string, slot, count uint64) (uint64, error) {
+	numElems := uint64(in.fieldCtx.NumElems())
+	if slot >= numElems || count > numElems-slot {
+		return 0, &evmmax.BoundsError{
+			Operand:  operand,
+			Offset:   uint(slot),
+			Stride:   1,
+			Count:    uint(count),
+			NumElems: uint(numElems),
+		}
+	}
+	return count * uint64(in.fieldCtx.ElemSize()), nil
+}
+
+// opStore pops dstSlot, srcOffset and count, and stores count field elements
+// from memory[srcOffset:] into the scratch space starting at dstSlot.
+func (in *Interpreter) opStore() error {
+	args, err := in.pop(3)
+	if err != nil {
+		return err
+	}
+	if in.fieldCtx == nil {
+		return ErrModulusNotSet
+	}
+	dstSlot, srcOffset, count := args[0], args[1], args[2]
+	size, err := in.elemRange("dst", dstSlot, count)
+	if err != nil {
+		return err
+	}
+	if err := in.useGas(in.fieldCtx.StoreCost(uint(count))); err != nil {
+		return err
+	}
+	src, err := in.memory(srcOffset, size)
+	if err != nil {
+		return err
+	}
+	return in.fieldCtx.StoreChecked(uint(dstSlot), uint(count), src)
+}
+
+// opLoad pops dstOffset, srcSlot and count, and loads count field elements
+// starting at srcSlot into memory[dstOffset:].
+func (in *Interpreter) opLoad() error {
+	args, err := in.pop(3)
+	if err != nil {
+		return err
+	}
+	if in.fieldCtx == nil {
+		return ErrModulusNotSet
+	}
+	dstOffset, srcSlot, count := args[0], args[1], args[2]
+	size, err := in.elemRange("from", srcSlot, count)
+	if err != nil {
+		return err
+	}
+	if err := in.useGas(in.fieldCtx.LoadCost(uint(count))); err != nil {
+		return err
+	}
+	dst, err := in.memory(dstOffset, size)
+	if err != nil {
+		return err
+	}
+	return in.fieldCtx.LoadChecked(dst, int(srcSlot), int(count))
+}
+
+// opArith executes ADDMODX, SUBMODX or MULMODX with operands taken from the
+// immediate bytes.
+func (in *Interpreter) opArith(op OpCode, imm []byte) error {
+	if in.fieldCtx == nil {
+		return ErrModulusNotSet
+	}
+	out, outStride, x, xStride, y, yStride, count := uint(imm[0]), uint(imm[1]), uint(imm[2]), uint(imm[3]), uint(imm[4]), uint(imm[5]), uint(imm[6])
+
+	var cost uint64
+	var arith func(out, outStride, x, xStride, y, yStride, count uint) error
+	switch op {
+	case ADDMODX:
+		cost, arith = in.fieldCtx.AddModCost(count), in.fieldCtx.AddModChecked
+	case SUBMODX:
+		cost, arith = in.fieldCtx.SubModCost(count), in.fieldCtx.SubModChecked
+	case MULMODX:
+		cost, arith = in.fieldCtx.MulModCost(count), in.fieldCtx.MulModChecked
+	}
+	if err := in.useGas(cost); err != nil {
+		return err
+	}
+	return arith(out, outStride, x, xStride, y, yStride, count)
+}
@@ -0,0 +1,230 @@
+package interpreter
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	evmmax "github.com/jwasinger/evmmax-arith"
+)
+
+// push returns the bytecode pushing val onto the stack.
+func push(val uint64) []byte {
+	if val == 0 {
+		return []byte{byte(PUSH0)}
+	}
+	var imm []byte
+	for ; val != 0; val >>= 8 {
+		imm = append([]byte{byte(val)}, imm...)
+	}
+	return append([]byte{byte(PUSH1) + byte(len(imm)-1)}, imm...)
+}
+
+// program concatenates bytecode fragments.
+func program(fragments ...[]byte) []byte {
+	return bytes.Join(fragments, nil)
+}
+
+func setmod(modOffset, modSize, allocCount uint64) []byte {
+	return program(push(allocCount), push(modSize), push(modOffset), []byte{byte(SETMODX)})
+}
+
+func storex(dstSlot, srcOffset, count uint64) []byte {
+	return program(push(count), push(srcOffset), push(dstSlot), []byte{byte(STOREX)})
+}
+
+func loadx(dstOffset, srcSlot, count uint64) []byte {
+	return program(push(count), push(srcSlot), push(dstOffset), []byte{byte(LOADX)})
+}
+
+func arith(op OpCode, out, outStride, x, xStride, y, yStride, count byte) []byte {
+	return []byte{byte(op), out, outStride, x, xStride, y, yStride, count}
+}
+
+// bn254 base field modulus
+var testModulus, _ = new(big.Int).SetString("30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47", 16)
+
+// newTestInterpreter returns an interpreter with the test modulus at memory
+// offset 0 followed by the given 32 byte values.
+func newTestInterpreter(gas uint64, vals ...*big.Int) *Interpreter {
+	in := New(gas)
+	in.Memory = append(in.Memory, evmmax.PadBytes(testModulus.Bytes(), 32)...)
+	for _, val := range vals {
+		in.Memory = append(in.Memory, evmmax.PadBytes(val.Bytes(), 32)...)
+	}
+	return in
+}
+
+func TestArithmeticProgram(t *testing.T) {
+	x, _ := new(big.Int).SetString("1234567890abcdef1234567890abcdef1234567890abcdef", 16)
+	y := new(big.Int).Sub(testModulus, big.NewInt(3))
+
+	ops := []struct {
+		op       OpCode
+		expected *big.Int
+	}{
+		{ADDMODX, new(big.Int).Add(x, y)},
+		{SUBMODX, new(big.Int).Sub(x, y)},
+		{MULMODX, new(big.Int).Mul(x, y)},
+	}
+	for _, c := range ops {
+		c.expected.Mod(c.expected, testModulus)
+
+		in := newTestInterpreter(100000, x, y)
+		code := program(
+			setmod(0, 32, 3),
+			storex(0, 32, 2),
+			arith(c.op, 2, 1, 0, 1, 1, 1, 1),
+			loadx(96, 2, 1),
+			[]byte{byte(STOP)},
+			// unreachable invalid opcode
+			[]byte{0xfe},
+		)
+		if err := in.Run(code); err != nil {
+			t.Fatalf("%v: unexpected error: %v", c.op, err)
+		}
+		if res := new(big.Int).SetBytes(in.Memory[96:128]); res.Cmp(c.expected) != 0 {
+			t.Fatalf("%v: received %x != expected %x", c.op, res, c.expected)
+		}
+		if in.Gas == 0 || in.Gas >= 100000 {
+			t.Fatalf("%v: unexpected remaining gas %d", c.op, in.Gas)
+		}
+		if len(in.Stack()) != 0 {
+			t.Fatalf("%v: expected empty stack", c.op)
+		}
+	}
+}
+
+func TestStridedProgram(t *testing.T) {
+	vals := []*big.Int{big.NewInt(2), big.NewInt(3), big.NewInt(5), big.NewInt(7)}
+	in := newTestInterpreter(100000, vals...)
+
+	// square every value in place, then load all of them
+	code := program(
+		setmod(0, 32, 4),
+		storex(0, 32, 4),
+		arith(MULMODX, 0, 1, 0, 1, 0, 1, 4),
+		loadx(32, 0, 4),
+	)
+	if err := in.Run(code); err != nil {
+		t.Fatal(err)
+	}
+	for i, val := range vals {
+		expected := new(big.Int).Mul(val, val)
+		if res := new(big.Int).SetBytes(in.Memory[32*(i+1) : 32*(i+2)]); res.Cmp(expected) != 0 {
+			t.Fatalf("element %d: received %s != expected %s", i, res, expected)
+		}
+	}
+}
+
+func TestProgramErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		gas      uint64
+		code     []byte
+		expected error
+	}{
+		{"invalid opcode", 100000, []byte{0xfe}, ErrInvalidOpcode},
+		{"stack underflow", 100000, program(push(1), []byte{byte(SETMODX)}), ErrStackUnderflow},
+		{"truncated push", 100000, []byte{byte(PUSH1) + 1, 0x01}, ErrTruncatedImmediate},
+		{"truncated arithmetic immediate", 100000, program(setmod(0, 32, 3), []byte{byte(ADDMODX), 0, 1, 0}), ErrTruncatedImmediate},
+		{"push overflow", 100000, append([]byte{byte(PUSH1) + 8, 1}, make([]byte, 8)...), ErrValueOverflow},
+		{"modulus not set", 100000, arith(MULMODX, 0, 1, 0, 1, 0, 1, 1), ErrModulusNotSet},
+		{"store before setmod", 100000, storex(0, 32, 1), ErrModulusNotSet},
+		{"out of gas", 10, setmod(0, 32, 3), ErrOutOfGas},
+		{"memory out of range", 100000, setmod(1<<40, 32, 1), ErrOutOfGas},
+		{"arithmetic out of bounds", 100000, program(setmod(0, 32, 3), arith(ADDMODX, 3, 1, 0, 1, 0, 1, 1)), evmmax.ErrOutOfBounds},
+		{"strided out of bounds", 100000, program(setmod(0, 32, 3), arith(MULMODX, 0, 3, 0, 1, 0, 1, 2)), evmmax.ErrOutOfBounds},
+		{"store out of bounds", 100000, program(setmod(0, 32, 3), storex(2, 32, 2)), evmmax.ErrOutOfBounds},
+		{"load out of bounds", 100000, program(setmod(0, 32, 3), loadx(0, 0, 4)), evmmax.ErrOutOfBounds},
+		{"memory expansion out of gas", 100000, program(setmod(0, 32, 3), loadx(1<<31, 0, 1)), ErrOutOfGas},
+	}
+	for _, c := range cases {
+		in := newTestInterpreter(c.gas, big.NewInt(1), big.NewInt(2))
+		if err := in.Run(c.code); !errors.Is(err, c.expected) {
+			t.Fatalf("%s: expected %v, got %v", c.name, c.expected, err)
+		}
+	}
+}
+
+func TestStoreUnreduced(t *testing.T) {
+	in := newTestInterpreter(100000, big.NewInt(1), testModulus)
+	err := in.Run(program(setmod(0, 32, 2), storex(0, 32, 2)))
+	var notReduced *evmmax.ValueNotReducedError
+	if !errors.As(err, &notReduced) || notReduced.Index != 1 {
+		t.Fatalf("expected value not reduced error for element 1, got %v", err)
+	}
+}
+
+func TestEvenModulusProgram(t *testing.T) {
+	// 6 * 2**64
+	mod := new(big.Int).Lsh(big.NewInt(6), 64)
+	in := New(100000)
+	in.Memory = append(in.Memory, evmmax.PadBytes(mod.Bytes(), 32)...)
+	// the modulus occupies 9 bytes, elements are padded to 16 bytes
+	in.Memory = append(in.Memory, evmmax.PadBytes(big.NewInt(5).Bytes(), 16)...)
+	in.Memory = append(in.Memory, evmmax.PadBytes(new(big.Int).Sub(mod, big.NewInt(1)).Bytes(), 16)...)
+
+	code := program(
+		setmod(32-9, 9, 3),
+		storex(0, 32, 2),
+		arith(MULMODX, 2, 1, 0, 1, 1, 1, 1),
+		loadx(64, 2, 1),
+	)
+	if err := in.Run(code); err != nil {
+		t.Fatal(err)
+	}
+	expected := new(big.Int).Sub(mod, big.NewInt(5))
+	if res := new(big.Int).SetBytes(in.Memory[64:80]); res.Cmp(expected) != 0 {
+		t.Fatalf("received %s != expected %s", res, expected)
+	}
+}
+
+func TestSetmodErrors(t *testing.T) {
+	in := newTestInterpreter(100000, big.NewInt(6))
+	if err := in.Run(setmod(32, 32, 1)); !errors.Is(err, evmmax.ErrModulusLeadingZero) {
+		t.Fatalf("expected leading zero modulus error, got %v", err)
+	}
+	if err := in.Run(setmod(32, 0, 1)); !errors.Is(err, evmmax.ErrModulusEmpty) {
+		t.Fatalf("expected empty modulus error, got %v", err)
+	}
+	if err := in.Run(setmod(0, 32, 257)); !errors.Is(err, evmmax.ErrScratchSpaceTooLarge) {
+		t.Fatalf("expected scratch space too large error, got %v", err)
+	}
+}
+
+func TestGasAccounting(t *testing.T) {
+	in := newTestInterpreter(100000, big.NewInt(1), big.NewInt(2))
+	if err := in.Run(setmod(0, 32, 2)); err != nil {
+		t.Fatal(err)
+	}
+	fieldCtx := in.FieldContext()
+	gas := in.Gas
+	if err := in.Run(arith(MULMODX, 0, 1, 0, 1, 0, 1, 2)); err != nil {
+		t.Fatal(err)
+	}
+	if gas-in.Gas != fieldCtx.MulModCost(2) {
+		t.Fatalf("expected MULMODX to cost %d, charged %d", fieldCtx.MulModCost(2), gas-in.Gas)
+	}
+}
+
+func TestPushGas(t *testing.T) {
+	for _, c := range []struct {
+		code []byte
+		cost uint64
+	}{
+		// the costs charged by the EVM
+		{push(0), 2},
+		{push(1), 3},
+		{push(1 << 40), 3},
+	} {
+		in := New(100)
+		if err := in.Run(c.code); err != nil {
+			t.Fatal(err)
+		}
+		if 100-in.Gas != c.cost {
+			t.Fatalf("%v: expected a cost of %d, charged %d", OpCode(c.code[0]), c.cost, 100-in.Gas)
+		}
+	}
+}
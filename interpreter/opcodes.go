@@ -0,0 +1,63 @@
+package interpreter
+
+import "fmt"
+
+// OpCode is a single byte instruction understood by the interpreter.
+type OpCode byte
+
+const (
+	STOP  OpCode = 0x00
+	PUSH0 OpCode = 0x5f
+	PUSH1 OpCode = 0x60
+	// PUSH2 ... PUSH31 are the consecutive opcodes between PUSH1 and PUSH32
+	PUSH32 OpCode = 0x7f
+
+	SETMODX OpCode = 0xc0
+	STOREX  OpCode = 0xc1
+	LOADX   OpCode = 0xc2
+	ADDMODX OpCode = 0xc3
+	SUBMODX OpCode = 0xc4
+	MULMODX OpCode = 0xc5
+)
+
+// arithImmediateSize is the number of immediate bytes following ADDMODX,
+// SUBMODX and MULMODX: out, outStride, x, xStride, y, yStride, count.
+const arithImmediateSize = 7
+
+var opCodeNames = map[OpCode]string{
+	STOP:    "STOP",
+	PUSH0:   "PUSH0",
+	SETMODX: "SETMODX",
+	STOREX:  "STOREX",
+	LOADX:   "LOADX",
+	ADDMODX: "ADDMODX",
+	SUBMODX: "SUBMODX",
+	MULMODX: "MULMODX",
+}
+
+// IsPush returns whether the opcode is one of PUSH1 ... PUSH32.
+func (op OpCode) IsPush() bool {
+	return op >= PUSH1 && op <= PUSH32
+}
+
+// immediateSize returns the number of immediate bytes which follow the opcode.
+func (op OpCode) immediateSize() int {
+	switch {
+	case op.IsPush():
+		return int(op-PUSH1) + 1
+	case op == ADDMODX || op == SUBMODX || op == MULMODX:
+		return arithImmediateSize
+	default:
+		return 0
+	}
+}
+
+func (op OpCode) String() string {
+	if name, ok := opCodeNames[op]; ok {
+		return name
+	}
+	if op.IsPush() {
+		return fmt.Sprintf("PUSH%d", op-PUSH1+1)
+	}
+	return fmt.Sprintf("opcode 0x%02x", byte(op))
+}
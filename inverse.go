@@ -0,0 +1,238 @@
+package evmmax_arith
+
+import (
+	"math/bits"
+)
+
+// Inverse sets the element at offset 'out' to the modular inverse of the
+// element at offset 'x', returning ErrNotInvertible if x shares a factor with
+// the modulus.  The scratch space is not modified if an error is returned.
+//
+// Inversion runs in constant time: a fixed number of safegcd divsteps
+// (Bernstein-Yang) for odd moduli, or a fixed number of Newton iterations for
+// power of two moduli.  it is not validated that inputs are within bounds: use
+// InverseChecked for untrusted inputs.
+func (m *FieldContext) Inverse(out, x uint) error {
+	if m.crt != nil {
+		return m.crt.inverse(out, x)
+	}
+	elemSize := uint(len(m.Modulus))
+	res := make([]uint64, elemSize)
+	if !m.invert(res, m.scratchSpace[x*elemSize:(x+1)*elemSize]) {
+		return ErrNotInvertible
+	}
+	copy(m.scratchSpace[out*elemSize:(out+1)*elemSize], res)
+	return nil
+}
+
+// BatchInverse computes 'count' modular inverses of the elements at offsets
+// [x, x+xStride, x+xStride*2, ..., x+xStride*(count - 1)], placing the results
+// in [out, out+outStride, out+outStride*2, ..., out+outStride*(count - 1)].
+// Montgomery's trick is used to perform a single inversion for the batch.
+//
+// If any of the elements is not invertible, ErrNotInvertible is returned and
+// the scratch space is not modified.  inputs/outputs can overlap without
+// affecting the result.  it is not validated that inputs are within bounds:
+// use BatchInverseChecked for untrusted inputs.
+func (m *FieldContext) BatchInverse(out, outStride, x, xStride, count uint) error {
+	if count == 0 {
+		return nil
+	}
+	if m.crt != nil {
+		return m.crt.batchInverse(out, outStride, x, xStride, count)
+	}
+	elemSize := uint(len(m.Modulus))
+	elem := func(idx uint) []uint64 {
+		offset := (x + idx*xStride) * elemSize
+		return m.scratchSpace[offset : offset+elemSize]
+	}
+
+	// prefix[i] = x_0 * x_1 * ... * x_i
+	prefix := make([]uint64, count*elemSize)
+	copy(prefix[:elemSize], elem(0))
+	for i := uint(1); i < count; i++ {
+		m.mulMod(prefix[i*elemSize:(i+1)*elemSize], prefix[(i-1)*elemSize:i*elemSize], elem(i), m.Modulus, m.modInv)
+	}
+
+	// inv = (x_0 * x_1 * ... * x_i)**-1, starting from the product of all elements
+	inv := make([]uint64, elemSize)
+	if !m.invert(inv, prefix[(count-1)*elemSize:]) {
+		return ErrNotInvertible
+	}
+	for i := count - 1; i > 0; i-- {
+		dst := (out + i*outStride) * elemSize
+		m.mulMod(m.outputWriteBuf[dst:dst+elemSize], inv, prefix[(i-1)*elemSize:i*elemSize], m.Modulus, m.modInv)
+		m.mulMod(inv, inv, elem(i), m.Modulus, m.modInv)
+	}
+	copy(m.outputWriteBuf[out*elemSize:(out+1)*elemSize], inv)
+
+	m.writeBack(out, outStride, count)
+	return nil
+}
+
+// invert sets out to the inverse of x, both in the internal representation.
+// It returns false if x is not invertible.
+func (m *FieldContext) invert(out, x []uint64) bool {
+	if m.isModulusBinary {
+		return m.invertBinary(out, x)
+	}
+
+	inv := make([]uint64, len(m.Modulus))
+	if !m.safegcdInverse(inv, x) {
+		return false
+	}
+	if !m.useMontgomeryRepr {
+		copy(out, inv)
+		return true
+	}
+	// x = aR, inverting the canonical value of x yields a**-1 * R**-1.
+	// multiplying by R**3 converts the result to Montgomery form: a**-1 * R
+	m.mulMod(out, inv, m.r3, m.Modulus, m.modInv)
+	return true
+}
+
+// invertBinary sets out to x**-1 modulo a power of two modulus using Newton
+// iteration: if x*y = 1 mod 2**k then x*y*(2 - x*y) = 1 mod 2**2k.  x is
+// invertible iff it is odd.
+func (m *FieldContext) invertBinary(out, x []uint64) bool {
+	elemSize := len(m.Modulus)
+	two := make([]uint64, elemSize)
+	two[0] = 2
+	y := make([]uint64, elemSize)
+	tmp := make([]uint64, elemSize)
+
+	// x*x = 1 mod 8 for all odd x
+	copy(y, x)
+	for correctBits := 3; correctBits < m.modulusInt.BitLen(); correctBits *= 2 {
+		m.mulMod(tmp, x, y, m.Modulus, m.modInv)
+		m.subMod(tmp, two, tmp, m.Modulus)
+		m.mulMod(y, y, tmp, m.Modulus, m.modInv)
+	}
+	copy(out, y)
+	// a modulus of 1 leaves every element (zero) invertible
+	return x[0]&1 == 1 || m.modulusInt.BitLen() == 1
+}
+
+// divstepIterations returns the number of divsteps sufficient to compute the
+// gcd of two values of up to 'bitLen' bits, from Bernstein and Yang, "Fast
+// constant-time gcd computation and modular inversion".
+func divstepIterations(bitLen int) int {
+	if bitLen < 46 {
+		return (49*bitLen + 80) / 17
+	}
+	return (49*bitLen + 57) / 17
+}
+
+// safegcdInverse sets out to x**-1 mod the (odd) modulus for canonical values,
+// performing a fixed number of constant-time divsteps.  It returns false if x
+// is not invertible.
+//
+// f and g are signed (two's complement) with one limb more than the modulus.
+// d and e are reduced by the modulus and maintain the invariants
+// f = d*x and g = e*x mod the modulus.
+func (m *FieldContext) safegcdInverse(out, x []uint64) bool {
+	n := len(m.Modulus)
+	f := make([]uint64, n+1)
+	g := make([]uint64, n+1)
+	d := make([]uint64, n)
+	e := make([]uint64, n)
+	tmp := make([]uint64, n)
+	zero := make([]uint64, n)
+	copy(f, m.Modulus)
+	copy(g, x)
+	e[0] = 1
+
+	delta := int64(1)
+	for i := 0; i < divstepIterations(m.modulusInt.BitLen()); i++ {
+		// if delta > 0 and g is odd:
+		//     delta, f, g, d, e = 1 - delta, g, (g - f) / 2, e, (e - d) / 2
+		// implemented as swapping (f, g) and (d, e), and negating g and e
+		swap := -((uint64(-delta) >> 63) & g[0] & 1)
+		ctSwap(f, g, swap)
+		ctSwap(d, e, swap)
+		ctNegate(g, swap)
+		m.subMod(tmp, zero, e, m.Modulus)
+		ctSelect(e, tmp, e, swap)
+		delta = (delta ^ int64(swap)) - int64(swap) + 1
+
+		// if g is odd: g, e = g + f, e + d
+		add := -(g[0] & 1)
+		ctAddMasked(g, f, add)
+		m.addMod(tmp, e, d, m.Modulus)
+		ctSelect(e, tmp, e, add)
+
+		// g, e = g / 2, e / 2
+		ctShiftRightSigned(g)
+		m.halve(e)
+	}
+
+	// g = 0, f = +/-gcd(x, mod): x is invertible iff f = +/-1
+	var isOne, isMinusOne uint64 = ^uint64(0), ^uint64(0)
+	for i := range f {
+		var one uint64
+		if i == 0 {
+			one = 1
+		}
+		isOne &= ctEqMask(f[i], one)
+		isMinusOne &= ctEqMask(f[i], ^uint64(0))
+	}
+	m.subMod(tmp, zero, d, m.Modulus)
+	ctSelect(out, tmp, d, isMinusOne)
+	return isOne|isMinusOne != 0
+}
+
+// halve sets x = x / 2 mod the (odd) modulus.
+func (m *FieldContext) halve(x []uint64) {
+	// if x is odd, x + mod is even
+	odd := -(x[0] & 1)
+	var c uint64
+	for i := range x {
+		x[i], c = bits.Add64(x[i], m.Modulus[i]&odd, c)
+	}
+	for i := 0; i < len(x)-1; i++ {
+		x[i] = x[i]>>1 | x[i+1]<<63
+	}
+	x[len(x)-1] = x[len(x)-1]>>1 | c<<63
+}
+
+// ctSwap swaps x and y if mask is all ones, and leaves them unchanged if mask is zero.
+func ctSwap(x, y []uint64, mask uint64) {
+	for i := range x {
+		t := (x[i] ^ y[i]) & mask
+		x[i] ^= t
+		y[i] ^= t
+	}
+}
+
+// ctSelect sets out to x if mask is all ones, or y if mask is zero.
+func ctSelect(out, x, y []uint64, mask uint64) {
+	for i := range out {
+		out[i] = (x[i] & mask) | (y[i] &^ mask)
+	}
+}
+
+// ctNegate negates the two's complement value x if mask is all ones.
+func ctNegate(x []uint64, mask uint64) {
+	// -x = ^x + 1
+	c := mask & 1
+	for i := range x {
+		x[i], c = bits.Add64(x[i]^mask, 0, c)
+	}
+}
+
+// ctAddMasked sets x = x + (y & mask).
+func ctAddMasked(x, y []uint64, mask uint64) {
+	var c uint64
+	for i := range x {
+		x[i], c = bits.Add64(x[i], y[i]&mask, c)
+	}
+}
+
+// ctShiftRightSigned performs an arithmetic right shift by one of the two's
+// complement value x.
+func ctShiftRightSigned(x []uint64) {
+	for i := 0; i < len(x)-1; i++ {
+		x[i] = x[i]>>1 | x[i+1]<<63
+	}
+	x[len(x)-1] = uint64(int64(x[len(x)-1]) >> 1)
+}
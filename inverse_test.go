@@ -0,0 +1,155 @@
+package evmmax_arith
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// loadInt returns the canonical value of the element at offset idx.
+func loadInt(fieldCtx *FieldContext, idx int) *big.Int {
+	res := make([]byte, fieldCtx.ElemSize())
+	fieldCtx.Load(res, idx, 1)
+	return new(big.Int).SetBytes(res)
+}
+
+// storeInt stores val at offset idx.
+func storeInt(t *testing.T, fieldCtx *FieldContext, idx uint, val *big.Int) {
+	if err := fieldCtx.Store(idx, 1, PadBytes(val.Bytes(), uint64(fieldCtx.ElemSize()))); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testInverse(t *testing.T, mod *big.Int, r *rand.Rand) {
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vals := []*big.Int{big.NewInt(1), new(big.Int).Sub(mod, big.NewInt(1))}
+	for i := 0; i < 6; i++ {
+		vals = append(vals, randBigInt(r, mod))
+	}
+	for _, val := range vals {
+		val.Mod(val, mod)
+		storeInt(t, fieldCtx, 0, val)
+		expected := new(big.Int).ModInverse(val, mod)
+		if mod.Cmp(big.NewInt(1)) == 0 {
+			expected = big.NewInt(0)
+		}
+
+		err := fieldCtx.Inverse(1, 0)
+		if expected == nil {
+			if !errors.Is(err, ErrNotInvertible) {
+				t.Fatalf("%s**-1 %% %s: expected error, got %v", val, mod, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s**-1 %% %s: unexpected error: %v", val, mod, err)
+		}
+		if res := loadInt(fieldCtx, 1); res.Cmp(expected) != 0 {
+			t.Fatalf("%s**-1 %% %s: received %s != expected %s", val, mod, res, expected)
+		}
+	}
+}
+
+func TestInverse(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 1; i <= 96; i += 5 {
+		mod := new(big.Int).SetBytes(randOddModulus(i))
+		t.Run(fmt.Sprintf("odd-%dbyte", i), func(t *testing.T) {
+			testInverse(t, mod, r)
+		})
+		mod = new(big.Int).SetBytes(randBinaryModulus(i - 1))
+		t.Run(fmt.Sprintf("binary-%dbyte", i), func(t *testing.T) {
+			testInverse(t, mod, r)
+		})
+		mod = new(big.Int).SetBytes(randEvenModulus(i))
+		t.Run(fmt.Sprintf("even-%dbyte", i), func(t *testing.T) {
+			testInverse(t, mod, r)
+		})
+	}
+	t.Run("bn254", func(t *testing.T) {
+		mod, _ := new(big.Int).SetString("30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47", 16)
+		testInverse(t, mod, r)
+	})
+	t.Run("max-modulus", func(t *testing.T) {
+		testInverse(t, limbsToInt(MaxModulus(12)), r)
+	})
+}
+
+func TestInverseNotInvertible(t *testing.T) {
+	// 3 * 5 * 7 * 2**64 + 3 * 5 * 7 shares factors with multiples of 3, 5 and 7
+	mod := new(big.Int).Lsh(big.NewInt(105), 64)
+	mod.Add(mod, big.NewInt(105))
+	fieldCtx, err := NewFieldContext(mod.Bytes(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, val := range []int64{0, 3, 35, 7 * 1000} {
+		storeInt(t, fieldCtx, 0, big.NewInt(val))
+		storeInt(t, fieldCtx, 1, big.NewInt(42))
+		if err := fieldCtx.Inverse(1, 0); !errors.Is(err, ErrNotInvertible) {
+			t.Fatalf("%d: expected error, got %v", val, err)
+		}
+		if loadInt(fieldCtx, 1).Int64() != 42 {
+			t.Fatalf("%d: output was modified by a failed inversion", val)
+		}
+	}
+
+	binaryCtx, err := NewFieldContext(randBinaryModulus(8), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storeInt(t, binaryCtx, 0, big.NewInt(1234))
+	if err := binaryCtx.Inverse(1, 0); !errors.Is(err, ErrNotInvertible) {
+		t.Fatalf("expected error inverting an even value modulo a power of two, got %v", err)
+	}
+}
+
+func TestBatchInverse(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, mod := range []*big.Int{
+		new(big.Int).SetBytes(randOddModulus(32)),
+		new(big.Int).SetBytes(randBinaryModulus(24)),
+		new(big.Int).SetBytes(randEvenModulus(40)),
+	} {
+		fieldCtx, err := NewFieldContext(mod.Bytes(), 16)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// invertible inputs at offsets 0, 2, 4, ..., 14
+		var expected []*big.Int
+		for i := 0; i < 8; i++ {
+			var val *big.Int
+			for val == nil || new(big.Int).ModInverse(val, mod) == nil {
+				val = randBigInt(r, mod)
+			}
+			storeInt(t, fieldCtx, uint(2*i), val)
+			expected = append(expected, new(big.Int).ModInverse(val, mod))
+		}
+
+		// outputs at offsets 14, 12, ..., 0 overlap the inputs
+		if err := fieldCtx.BatchInverse(0, 2, 0, 2, 8); err != nil {
+			t.Fatal(err)
+		}
+		for i, exp := range expected {
+			if res := loadInt(fieldCtx, 2*i); res.Cmp(exp) != 0 {
+				t.Fatalf("%s: element %d: received %s != expected %s", mod, i, res, exp)
+			}
+		}
+
+		// a non-invertible element fails the batch without modifying the scratch space
+		storeInt(t, fieldCtx, 1, big.NewInt(0))
+		if err := fieldCtx.BatchInverse(0, 1, 0, 1, 4); !errors.Is(err, ErrNotInvertible) {
+			t.Fatalf("expected error, got %v", err)
+		}
+		if res := loadInt(fieldCtx, 0); res.Cmp(expected[0]) != 0 {
+			t.Fatalf("scratch space was modified by a failed batch inversion")
+		}
+	}
+}
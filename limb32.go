@@ -0,0 +1,75 @@
+//go:build 386 || arm || mipsle || wasm || (limb32 && (amd64 || arm64 || loong64 || mips64le || ppc64le || riscv64))
+
+package evmmax_arith
+
+import (
+	"math/bits"
+	"unsafe"
+)
+
+// On 32-bit and wasm targets (or with the limb32 build tag), Montgomery
+// multiplication, addition and subtraction use the generated 32-bit limb
+// family.  Field elements keep their 64-bit limb representation: on
+// little-endian platforms, n 64-bit limbs are laid out in memory exactly as 2n
+// 32-bit limbs of the same value.  The Montgomery radix R = 2**(64n) is also
+// unchanged, and the 32-bit modInv is the low half of the 64-bit one.
+
+// mulFunc32 and addOrSubFunc32 are the 32-bit limb counterparts of mulFunc and
+// addOrSubFunc.
+type mulFunc32 func(out, x, y, mod []uint32, modInv uint32)
+type addOrSubFunc32 func(out, x, y, mod []uint32)
+
+// madd0u32 hi = a*b + c (discards lo bits)
+func madd0u32(a, b, c uint32) uint32 {
+	var carry, lo uint32
+	hi, lo := bits.Mul32(a, b)
+	_, carry = bits.Add32(lo, c, 0)
+	hi, _ = bits.Add32(hi, 0, carry)
+	return hi
+}
+
+// madd1u32 hi, lo = a*b + c
+func madd1u32(a, b, c uint32) (uint32, uint32) {
+	var carry uint32
+	hi, lo := bits.Mul32(a, b)
+	lo, carry = bits.Add32(lo, c, 0)
+	hi, _ = bits.Add32(hi, 0, carry)
+	return hi, lo
+}
+
+// madd2u32 hi, lo = a*b + c + d
+func madd2u32(a, b, c, d uint32) (uint32, uint32) {
+	var carry uint32
+	hi, lo := bits.Mul32(a, b)
+	c, carry = bits.Add32(c, d, 0)
+	hi, _ = bits.Add32(hi, 0, carry)
+	lo, carry = bits.Add32(lo, c, 0)
+	hi, _ = bits.Add32(hi, 0, carry)
+	return hi, lo
+}
+
+// limbs32 reinterprets 64-bit limbs as twice as many 32-bit limbs
+func limbs32(x []uint64) []uint32 {
+	return unsafe.Slice((*uint32)(unsafe.Pointer(unsafe.SliceData(x))), 2*len(x))
+}
+
+// arith32 returns Montgomery multiplication, addition and subtraction for
+// moduli of the given number of 64-bit limbs, implemented on 32-bit limbs.
+func arith32(limbs int) (mulFunc, addOrSubFunc, addOrSubFunc) {
+	if 2*limbs > len(mulmod32Preset) {
+		return nil, nil, nil
+	}
+	mul32 := mulmod32Preset[2*limbs-1]
+	add32 := addmod32Preset[2*limbs-1]
+	sub32 := submod32Preset[2*limbs-1]
+	mul := func(out, x, y, mod []uint64, modInv uint64) {
+		mul32(limbs32(out), limbs32(x), limbs32(y), limbs32(mod), uint32(modInv))
+	}
+	add := func(out, x, y, mod []uint64) {
+		add32(limbs32(out), limbs32(x), limbs32(y), limbs32(mod))
+	}
+	sub := func(out, x, y, mod []uint64) {
+		sub32(limbs32(out), limbs32(x), limbs32(y), limbs32(mod))
+	}
+	return mul, add, sub
+}
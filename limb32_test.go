@@ -0,0 +1,126 @@
+//go:build 386 || arm || mipsle || wasm || (limb32 && (amd64 || arm64 || loong64 || mips64le || ppc64le || riscv64))
+
+package evmmax_arith
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// The helpers below are called by the generated per-width tests and
+// benchmarks of the 32-bit limb family (generated_arith_limb32_test.go).  The
+// generated functions are checked against math/big, including at odd 32-bit
+// limb counts which field contexts never use.
+
+func limbs32ToInt(limbs []uint32) *big.Int {
+	res := new(big.Int)
+	for i := len(limbs) - 1; i >= 0; i-- {
+		res.Lsh(res, 32)
+		res.Or(res, big.NewInt(int64(limbs[i])))
+	}
+	return res
+}
+
+func intToLimbs32(val *big.Int, limbs int) []uint32 {
+	res := make([]uint32, limbs)
+	words := new(big.Int).Set(val)
+	mask := big.NewInt(0xffffffff)
+	for i := range res {
+		res[i] = uint32(new(big.Int).And(words, mask).Uint64())
+		words.Rsh(words, 32)
+	}
+	return res
+}
+
+// testModuli32 returns odd test moduli of the given 32-bit limb count: random,
+// the largest, and the largest with two spare bits in the top limb.
+func testModuli32(limbs int) []*big.Int {
+	bitLen := uint(limbs * 32)
+	random := new(big.Int).SetBytes(randOddModulus(limbs * 4))
+	random.SetBit(random, int(bitLen-1), 1)
+	max := new(big.Int).Lsh(big.NewInt(1), bitLen)
+	max.Sub(max, big.NewInt(1))
+	spare := new(big.Int).Rsh(max, 2)
+	return []*big.Int{random, max, spare}
+}
+
+// checkGenerated32 checks op on 32-bit limbs against the result of expected
+// for each test modulus, on every pair of the operands 0, 1, mod-1 and random
+// ones.
+func checkGenerated32(t *testing.T, limbs int, op func(out, x, y, mod []uint32), expected func(x, y, mod *big.Int) *big.Int) {
+	t.Helper()
+	r := rand.New(rand.NewSource(42))
+	for _, modInt := range testModuli32(limbs) {
+		mod := intToLimbs32(modInt, limbs)
+		operands := []*big.Int{big.NewInt(0), big.NewInt(1), new(big.Int).Sub(modInt, big.NewInt(1))}
+		for i := 0; i < generatedRandomOperands; i++ {
+			operands = append(operands, randBigInt(r, modInt))
+		}
+		out := make([]uint32, limbs)
+		for _, xInt := range operands {
+			for _, yInt := range operands {
+				x, y := intToLimbs32(xInt, limbs), intToLimbs32(yInt, limbs)
+				op(out, x, y, mod)
+				if res := expected(xInt, yInt, modInt); limbs32ToInt(out).Cmp(res) != 0 {
+					t.Fatalf("%s mismatch for x=%x y=%x mod=%x: %x != %x", t.Name(), x, y, mod, out, res)
+				}
+			}
+		}
+	}
+}
+
+func checkGeneratedMontMul32(t *testing.T, mul mulFunc32, limbs int) {
+	checkGenerated32(t, limbs, func(out, x, y, mod []uint32) {
+		mul(out, x, y, mod, uint32(negModInverse(uint64(mod[0]))))
+	}, func(x, y, mod *big.Int) *big.Int {
+		rInv := new(big.Int).Lsh(big.NewInt(1), uint(limbs*32))
+		rInv.ModInverse(rInv, mod)
+		res := new(big.Int).Mul(x, y)
+		res.Mul(res, rInv)
+		return res.Mod(res, mod)
+	})
+}
+
+func checkGeneratedAddMod32(t *testing.T, add addOrSubFunc32, limbs int) {
+	checkGenerated32(t, limbs, add, func(x, y, mod *big.Int) *big.Int {
+		res := new(big.Int).Add(x, y)
+		return res.Mod(res, mod)
+	})
+}
+
+func checkGeneratedSubMod32(t *testing.T, sub addOrSubFunc32, limbs int) {
+	checkGenerated32(t, limbs, sub, func(x, y, mod *big.Int) *big.Int {
+		res := new(big.Int).Sub(x, y)
+		return res.Mod(res, mod)
+	})
+}
+
+// benchmarkGenerated32 benchmarks op in isolation on random operands reduced
+// by the largest modulus of the given 32-bit limb count.
+func benchmarkGenerated32(b *testing.B, limbs int, op func(out, x, y, mod []uint32)) {
+	r := rand.New(rand.NewSource(42))
+	modInt := testModuli32(limbs)[1]
+	mod := intToLimbs32(modInt, limbs)
+	x, y := intToLimbs32(randBigInt(r, modInt), limbs), intToLimbs32(randBigInt(r, modInt), limbs)
+	out := make([]uint32, limbs)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		op(out, x, y, mod)
+	}
+}
+
+func benchmarkGeneratedMontMul32(b *testing.B, mul mulFunc32, limbs int) {
+	benchmarkGenerated32(b, limbs, func(out, x, y, mod []uint32) {
+		// -mod**-1 mod 2**32 is 1 for the largest modulus
+		mul(out, x, y, mod, 1)
+	})
+}
+
+func benchmarkGeneratedAddMod32(b *testing.B, add addOrSubFunc32, limbs int) {
+	benchmarkGenerated32(b, limbs, add)
+}
+
+func benchmarkGeneratedSubMod32(b *testing.B, sub addOrSubFunc32, limbs int) {
+	benchmarkGenerated32(b, limbs, sub)
+}
@@ -0,0 +1,8 @@
+//go:build !(386 || arm || mipsle || wasm || (limb32 && (amd64 || arm64 || loong64 || mips64le || ppc64le || riscv64)))
+
+package evmmax_arith
+
+// arith32 returns nil: the 32-bit limb family is not used on this platform.
+func arith32(limbs int) (mulFunc, addOrSubFunc, addOrSubFunc) {
+	return nil, nil, nil
+}
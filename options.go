@@ -0,0 +1,83 @@
+package evmmax_arith
+
+// Backend selects the implementation of the arithmetic used by a FieldContext.
+type Backend int
+
+const (
+	// BackendGenerated uses the unrolled generated arithmetic for moduli of up
+	// to 12 limbs, and the loop-based arithmetic for wider moduli.
+	BackendGenerated Backend = iota
+	// BackendReference uses the straightforward loop-based CIOS arithmetic for
+	// every modulus width.  It serves as the oracle which the generated code is
+	// tested against, and can be used to rule out code generation bugs.
+	BackendReference
+	// BackendPseudoMersenne reduces products modulo 2**k - c for a small c by
+	// folding instead of Montgomery reduction, and keeps values in canonical
+	// form.  BackendGenerated selects it for such moduli where it is faster.
+	// NewFieldContext returns ErrBackendUnsupported for other moduli.
+	BackendPseudoMersenne
+	// BackendBarrett reduces products of odd moduli with Barrett reduction
+	// and keeps values in canonical form.  Its multiplication is slower than
+	// Montgomery multiplication, but Store and Load perform no conversion,
+	// which benefits workloads dominated by them.  It is a performance option
+	// only: gas costs are those of Montgomery arithmetic.  Powers of two use
+	// the same arithmetic as with BackendGenerated.
+	BackendBarrett
+)
+
+// String returns the name of the backend
+func (b Backend) String() string {
+	switch b {
+	case BackendGenerated:
+		return "generated"
+	case BackendReference:
+		return "reference"
+	case BackendPseudoMersenne:
+		return "pseudo-mersenne"
+	case BackendBarrett:
+		return "barrett"
+	default:
+		return "unknown"
+	}
+}
+
+// config holds the parameters set by the options passed to NewFieldContext
+type config struct {
+	backend        Backend
+	constTimeStore bool
+}
+
+// Option configures a FieldContext created with NewFieldContext
+type Option func(*config)
+
+// WithBackend selects the arithmetic backend of a FieldContext
+func WithBackend(backend Backend) Option {
+	return func(c *config) {
+		c.backend = backend
+	}
+}
+
+// WithConstantTimeStore makes Store check that values are reduced without
+// branching on their contents, so that storing secret values does not leak
+// their magnitude through timing.  Every value is checked before any is
+// stored, and the index of the first unreduced value is still reported.
+// Contexts for even moduli which are not a power of two convert values with
+// math/big and are not constant time.
+func WithConstantTimeStore() Option {
+	return func(c *config) {
+		c.constTimeStore = true
+	}
+}
+
+func newConfig(opts []Option) (*config, error) {
+	c := &config{backend: BackendGenerated}
+	for _, opt := range opts {
+		opt(c)
+	}
+	switch c.backend {
+	case BackendGenerated, BackendReference, BackendPseudoMersenne, BackendBarrett:
+	default:
+		return nil, ErrUnknownBackend
+	}
+	return c, nil
+}
@@ -0,0 +1,172 @@
+package evmmax_arith
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// Arithmetic for moduli of the special form p = 2**k - c with a small c, such
+// as the pseudo-Mersenne primes 2**255 - 19 and secp256k1's
+// 2**256 - 2**32 - 977 and the Solinas prime 2**64 - 2**32 + 1.  A product is
+// reduced by folding: writing it as hi * 2**k + lo, it is congruent to
+// hi * c + lo, which is much smaller.  Values are kept in canonical form.
+
+// pseudoMersenneMulFunc is the type of the generated multiplications modulo
+// 2**k - c, where bit k is at position shift of the top limb.
+type pseudoMersenneMulFunc func(out, x, y, mod []uint64, c uint64, shift uint, folds int)
+
+// maxPseudoMersenneFolds is the maximum number of folds reducing a product
+// below 2p.  Moduli requiring more use Montgomery arithmetic.
+const maxPseudoMersenneFolds = 3
+
+// pseudoMersenne holds the parameters of a modulus 2**k - c, and buffers for
+// the intermediate values of the loop-based multiplication.
+type pseudoMersenne struct {
+	limbs  int
+	c      uint64
+	shift  uint   // position of bit k within limb limbs-1, in (0, 64]
+	loMask uint64 // mask of the bits of limb limbs-1 below bit k
+	folds  int    // number of folds reducing a product below 2p
+
+	z, t    []uint64
+	scratch []uint64 // Karatsuba scratch space, for wide moduli only
+}
+
+// detectPseudoMersenne returns the parameters of the odd modulus mod if it is
+// of the form 2**k - c with c less than 2**63 and small enough for products
+// to be reduced by at most maxPseudoMersenneFolds folds, or nil.
+func detectPseudoMersenne(mod *big.Int) *pseudoMersenne {
+	k := mod.BitLen()
+	limbs := (k + 63) / 64
+	c := new(big.Int).Lsh(big.NewInt(1), uint(k))
+	c.Sub(c, mod)
+	if c.BitLen() > 63 {
+		return nil
+	}
+
+	// bound the value after each fold, starting from the largest product,
+	// until it is less than 2p
+	loMax := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(k)), big.NewInt(1))
+	twoP := new(big.Int).Lsh(mod, 1)
+	bound := new(big.Int).Sub(mod, big.NewInt(1))
+	bound.Mul(bound, bound)
+	folds := 0
+	for folds == 0 || bound.Cmp(twoP) >= 0 {
+		if folds == maxPseudoMersenneFolds {
+			return nil
+		}
+		hi := new(big.Int).Rsh(bound, uint(k))
+		bound.Add(loMax, hi.Mul(hi, c))
+		folds++
+	}
+
+	shift := uint(k - 64*(limbs-1))
+	pm := &pseudoMersenne{
+		limbs:  limbs,
+		c:      c.Uint64(),
+		shift:  shift,
+		loMask: 1<<shift - 1,
+		folds:  folds,
+		z:      make([]uint64, 2*limbs),
+		t:      make([]uint64, limbs+1),
+	}
+	if limbs >= karatsubaLimbs {
+		pm.scratch = make([]uint64, karatsubaScratchSize(limbs))
+	}
+	return pm
+}
+
+// fold sets out = hi * c + lo where z = hi * 2**k + lo.  len(out) is limbs+1,
+// the result must fit in it, and z must have at least limbs+1 limbs.  out may
+// alias z.
+func (pm *pseudoMersenne) fold(out, z []uint64) {
+	n := pm.limbs
+	var carry, mulCarry uint64
+	for i := 0; i <= n; i++ {
+		// limb i of hi = z >> k.  A shift by 64 yields zero.
+		var hi uint64
+		if n-1+i < len(z) {
+			hi = z[n-1+i] >> pm.shift
+		}
+		if n+i < len(z) {
+			hi |= z[n+i] << (64 - pm.shift)
+		}
+		var lo uint64
+		if i < n-1 {
+			lo = z[i]
+		} else if i == n-1 {
+			lo = z[i] & pm.loMask
+		}
+
+		prodHi, prodLo := bits.Mul64(hi, pm.c)
+		prodLo, cc := bits.Add64(prodLo, mulCarry, 0)
+		mulCarry = prodHi + cc
+		out[i], carry = bits.Add64(lo, prodLo, carry)
+	}
+}
+
+// mulMod computes out = x * y % p.  It does not branch on the values of x
+// and y.
+func (pm *pseudoMersenne) mulMod(out, x, y, mod []uint64, _ uint64) {
+	n := pm.limbs
+	z, t := pm.z, pm.t
+
+	if pm.scratch != nil {
+		karatsubaMul(z, x, y, pm.scratch)
+	} else {
+		schoolbookMul(z, x, y)
+	}
+	pm.fold(t, z)
+	for i := 1; i < pm.folds; i++ {
+		pm.fold(t, t)
+	}
+	// t < 2p, so its top limb is at most one
+	finalSub(out, t[:n], t[n], mod)
+}
+
+// pseudoMersenneMinLimbs is the limb count from which folding outperforms the
+// generated Montgomery multiplication, unless the latter uses the assembly (see
+// BenchmarkPseudoMersenne).
+const pseudoMersenneMinLimbs = 4
+
+// selectPseudoMersenne returns the parameters of the odd modulus mod if the
+// backend reduces by folding for it, or nil.  BackendPseudoMersenne does so
+// for every modulus of a special form, and BackendGenerated only where it
+// outperforms the Montgomery arithmetic which would be selected otherwise:
+// 2**255 - 19 and secp256k1's modulus are reduced by folding unless the amd64
+// assembly or the 32-bit limb family applies.  Folding takes precedence over
+// the arithmetic specialized for secp256k1's modulus, which it matches.
+func selectPseudoMersenne(mod *big.Int, modLimbs []uint64, backend Backend) *pseudoMersenne {
+	limbs := len(modLimbs)
+	switch backend {
+	case BackendPseudoMersenne:
+		return detectPseudoMersenne(mod)
+	case BackendGenerated:
+		if limbs < pseudoMersenneMinLimbs {
+			return nil
+		}
+		if limbs <= len(mulmodPreset) {
+			if mul, _, _ := arith32(limbs); mul != nil || selectMontVariant(modLimbs) == montADX {
+				return nil
+			}
+		}
+		return detectPseudoMersenne(mod)
+	default:
+		return nil
+	}
+}
+
+// pseudoMersenneArith returns the arithmetic for a modulus of the special form
+// described by pm: the generated multiplication where available, and the
+// loop-based one otherwise.
+func pseudoMersenneArith(pm *pseudoMersenne) (mulFunc, sqrFunc, addOrSubFunc, addOrSubFunc) {
+	if pm.limbs > len(mulmodPseudoMersennePreset) {
+		return pm.mulMod, sqrFromMul(pm.mulMod), addModGeneric, subModGeneric
+	}
+	generated := mulmodPseudoMersennePreset[pm.limbs-1]
+	c, shift, folds := pm.c, pm.shift, pm.folds
+	mul := func(out, x, y, mod []uint64, _ uint64) {
+		generated(out, x, y, mod, c, shift, folds)
+	}
+	return mul, sqrFromMul(mul), addmodPreset[pm.limbs-1], submodPreset[pm.limbs-1]
+}
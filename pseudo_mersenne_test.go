@@ -0,0 +1,150 @@
+package evmmax_arith
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestDetectPseudoMersenne(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		mod   *big.Int
+		c     uint64
+		folds int
+	}{
+		{"25519", sqrtTestPrimes["25519"], 19, 2},
+		{"secp256k1-fp", sqrtTestPrimes["secp256k1-fp"], 1<<32 + 977, 2},
+		{"goldilocks", sqrtTestPrimes["goldilocks"], 1<<32 - 1, 2},
+		{"p-521", mustHex("1" + strings.Repeat("f", 130)), 1, 1},
+		// c spans several limbs
+		{"p-256", mustHex("ffffffff00000001000000000000000000000000ffffffffffffffffffffffff"), 0, 0},
+		{"bn254-fp", sqrtTestPrimes["bn254-fp"], 0, 0},
+		{"97", big.NewInt(97), 0, 0},
+	} {
+		pm := detectPseudoMersenne(test.mod)
+		if test.folds == 0 {
+			if pm != nil {
+				t.Fatalf("%s: unexpected special form %+v", test.name, pm)
+			}
+			continue
+		}
+		if pm == nil || pm.c != test.c || pm.folds != test.folds {
+			t.Fatalf("%s: expected c = %#x with %d folds, got %+v", test.name, test.c, test.folds, pm)
+		}
+	}
+}
+
+// TestPseudoMersenneLoop checks the loop-based multiplication used for moduli
+// wider than the generated presets.
+func TestPseudoMersenneLoop(t *testing.T) {
+	for _, limbs := range []int{1, 4, 13, 16, karatsubaLimbs, 33, maxLimbs} {
+		moduli := pseudoMersenneTestModuli(limbs)
+		if len(moduli) == 0 {
+			t.Fatalf("no pseudo-Mersenne test moduli of %d limbs", limbs)
+		}
+		for _, modInt := range moduli {
+			pm := detectPseudoMersenne(modInt)
+			mod := bytesToLimbs(PadBytes(modInt.Bytes(), uint64(limbs*8)))
+			checkMul(t, pm.mulMod, mulModBigInt, [][]uint64{mod})
+		}
+	}
+}
+
+// TestPseudoMersenneFieldContext checks the operations of field contexts
+// using pseudo-Mersenne arithmetic against math/big.
+func TestPseudoMersenneFieldContext(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	moduli := []*big.Int{
+		sqrtTestPrimes["25519"],
+		sqrtTestPrimes["secp256k1-fp"],
+		sqrtTestPrimes["goldilocks"],
+		mustHex("1" + strings.Repeat("f", 130)), // 2**521 - 1
+	}
+	for _, limbs := range []int{13, karatsubaLimbs} {
+		moduli = append(moduli, pseudoMersenneTestModuli(limbs)[0])
+	}
+	for _, mod := range moduli {
+		t.Run(fmt.Sprintf("%d-bit", mod.BitLen()), func(t *testing.T) {
+			testCanonicalFieldContext(t, r, mod, BackendPseudoMersenne)
+		})
+	}
+}
+
+func TestPseudoMersenneSelection(t *testing.T) {
+	for _, mod := range []*big.Int{
+		sqrtTestPrimes["bn254-fp"],
+		mustHex("ffffffff00000001000000000000000000000000ffffffffffffffffffffffff"),
+		big.NewInt(96),
+		big.NewInt(1 << 10),
+	} {
+		_, err := NewFieldContext(mod.Bytes(), 1, WithBackend(BackendPseudoMersenne))
+		if !errors.Is(err, ErrBackendUnsupported) {
+			t.Fatalf("%x: expected ErrBackendUnsupported, got %v", mod, err)
+		}
+	}
+
+	// small moduli of the special form keep the faster Montgomery arithmetic
+	fieldCtx, err := NewFieldContext(sqrtTestPrimes["goldilocks"].Bytes(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fieldCtx.Backend() != BackendGenerated {
+		t.Fatalf("goldilocks: unexpected backend %s", fieldCtx.Backend())
+	}
+	// from four limbs, folding is selected unless the amd64 assembly or the
+	// 32-bit limb family applies
+	for _, name := range []string{"25519", "secp256k1-fp"} {
+		mod := sqrtTestPrimes[name]
+		fieldCtx, err := NewFieldContext(mod.Bytes(), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := BackendPseudoMersenne
+		modLimbs := bytesToLimbs(PadBytes(mod.Bytes(), 32))
+		if mul, _, _ := arith32(4); mul != nil || selectMontVariant(modLimbs) == montADX {
+			expected = BackendGenerated
+		}
+		if fieldCtx.Backend() != expected {
+			t.Fatalf("%s: expected backend %s, got %s", name, expected, fieldCtx.Backend())
+		}
+	}
+	// wide ones are reduced by folding
+	mod := pseudoMersenneTestModuli(13)[0]
+	fieldCtx, err = NewFieldContext(mod.Bytes(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fieldCtx.Backend() != BackendPseudoMersenne {
+		t.Fatalf("%d-bit modulus: unexpected backend %s", mod.BitLen(), fieldCtx.Backend())
+	}
+}
+
+// BenchmarkPseudoMersenne compares multiplication by folding against the
+// Montgomery multiplication montgomeryArith selects for the same modulus.  It
+// justifies the selection rule of selectPseudoMersenne: folding wins from four
+// limbs, but not against the amd64 assembly.
+func BenchmarkPseudoMersenne(b *testing.B) {
+	moduli := map[string]*big.Int{
+		"25519":        sqrtTestPrimes["25519"],
+		"secp256k1-fp": sqrtTestPrimes["secp256k1-fp"],
+	}
+	for limbs := 1; limbs <= 6; limbs++ {
+		moduli[fmt.Sprintf("%d-bit", limbs*64)] = pseudoMersenneTestModuli(limbs)[0]
+	}
+	for name, modInt := range moduli {
+		pm := detectPseudoMersenne(modInt)
+		mod := bytesToLimbs(PadBytes(modInt.Bytes(), uint64(pm.limbs*8)))
+		foldMul, _, _, _ := pseudoMersenneArith(pm)
+		montMul, _, _, _ := montgomeryArith(mod, BackendGenerated)
+		b.Run(name+"-folding", func(b *testing.B) {
+			benchmarkMontMul(b, foldMul, mod)
+		})
+		b.Run(name+"-montgomery", func(b *testing.B) {
+			benchmarkMontMul(b, montMul, mod)
+		})
+	}
+}
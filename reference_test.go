@@ -0,0 +1,231 @@
+package evmmax_arith
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+const differentialRepeat = 1000
+
+// randLimbs returns a random value less than mod
+func randLimbs(r *rand.Rand, mod []uint64) []uint64 {
+	val := new(big.Int).Rand(r, limbsToInt(mod))
+	return bytesToLimbs(PadBytes(val.Bytes(), uint64(len(mod)*8)))
+}
+
+// differentialModuli returns odd test moduli of the given limb count: random,
+// the largest, random with two spare bits in the top limb, and ones with a
+// single significant bit in the top limb.
+func differentialModuli(r *rand.Rand, limbs int) [][]uint64 {
+	random := bytesToLimbs(randOddModulus(limbs * 8))
+	random[limbs-1] |= 1 << 63
+	spare := bytesToLimbs(randOddModulus(limbs * 8))
+	spare[limbs-1] = spare[limbs-1]>>2 | 1<<61
+	spare[0] |= 1
+	small := make([]uint64, limbs)
+	small[0] = 1
+	small[limbs-1] |= 1
+	if limbs == 1 {
+		small[0] = 3
+	}
+	return [][]uint64{random, MaxModulus(limbs), spare, small}
+}
+
+// edgeValues returns the operands 0, 1 and mod-1
+func edgeValues(mod []uint64) [][]uint64 {
+	zero := make([]uint64, len(mod))
+	one := make([]uint64, len(mod))
+	one[0] = 1
+	max := make([]uint64, len(mod))
+	copy(max, mod)
+	for i := range max {
+		max[i]--
+		if max[i] != ^uint64(0) {
+			break
+		}
+	}
+	return [][]uint64{zero, one, max}
+}
+
+func checkLimbsEqual(t *testing.T, name string, got, expected, x, y, mod []uint64) {
+	t.Helper()
+	if !eq(got, expected) {
+		t.Fatalf("%s mismatch for x=%x y=%x mod=%x: generated %x != reference %x", name, x, y, mod, got, expected)
+	}
+}
+
+// TestGeneratedAgainstReference checks the generated arithmetic of every
+// width, including every applicable Montgomery multiplication variant, against
+// the loop-based reference implementation.
+func TestGeneratedAgainstReference(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for limbs := 1; limbs <= len(mulmodPreset); limbs++ {
+		for _, mod := range differentialModuli(r, limbs) {
+			modInv := negModInverse(mod[0])
+			_, sqr, add, sub := montgomeryArith(mod, BackendGenerated)
+			var muls []mulFunc
+			var names []string
+			for _, v := range montVariants {
+				if mul := v.mulFunc(limbs); mul != nil && v.applicable(mod) {
+					muls = append(muls, mul)
+					names = append(names, fmt.Sprintf("mulmont (%s)", v))
+				}
+			}
+
+			t.Run(fmt.Sprintf("%d-limbs-%x", limbs, mod[limbs-1]), func(t *testing.T) {
+				got := make([]uint64, limbs)
+				expected := make([]uint64, limbs)
+				check := func(x, y []uint64) {
+					montMulGeneric(expected, x, y, mod, modInv)
+					for i, mul := range muls {
+						mul(got, x, y, mod, modInv)
+						checkLimbsEqual(t, names[i], got, expected, x, y, mod)
+					}
+
+					sqr(got, x, mod, modInv)
+					montMulGeneric(expected, x, x, mod, modInv)
+					checkLimbsEqual(t, "sqrmont", got, expected, x, x, mod)
+
+					add(got, x, y, mod)
+					addModGeneric(expected, x, y, mod)
+					checkLimbsEqual(t, "addmod", got, expected, x, y, mod)
+
+					sub(got, x, y, mod)
+					subModGeneric(expected, x, y, mod)
+					checkLimbsEqual(t, "submod", got, expected, x, y, mod)
+				}
+
+				edges := edgeValues(mod)
+				for _, x := range edges {
+					for _, y := range edges {
+						check(x, y)
+					}
+				}
+				for i := 0; i < differentialRepeat; i++ {
+					check(randLimbs(r, mod), randLimbs(r, mod))
+				}
+
+				// the output may alias an input
+				x, y := randLimbs(r, mod), randLimbs(r, mod)
+				montMulGeneric(expected, x, y, mod, modInv)
+				for i, mul := range muls {
+					aliased := append([]uint64{}, x...)
+					mul(aliased, aliased, y, mod, modInv)
+					checkLimbsEqual(t, names[i]+" aliased", aliased, expected, x, y, mod)
+				}
+			})
+		}
+	}
+}
+
+func TestSelectMontMul(t *testing.T) {
+	for limbs := 1; limbs <= len(mulmodPreset); limbs++ {
+		if len(montVariantRanking[limbs-1]) == 0 {
+			t.Fatalf("no Montgomery multiplication variants ranked for %d limbs", limbs)
+		}
+		// the no-carry variant must not be selected for moduli without a
+		// spare bit
+		mod := MaxModulus(limbs)
+		if v := selectMontVariant(mod); v == montNoCarry {
+			t.Fatalf("no-carry variant selected for %d limb modulus %x", limbs, mod)
+		}
+		if v := selectMontVariant(mod); v.mulFunc(limbs) == nil {
+			t.Fatalf("unavailable variant %s selected for %d limbs", v, limbs)
+		}
+	}
+}
+
+// TestGeneratedBinaryAgainstReference checks the generated power of two
+// arithmetic of every width against the loop-based reference implementation.
+func TestGeneratedBinaryAgainstReference(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for limbs := 1; limbs <= len(mulmodBinaryPreset); limbs++ {
+		for _, topBit := range []uint{0, 31, 63} {
+			mod := make([]uint64, limbs)
+			mod[limbs-1] = 1 << topBit
+			mul, _, add, sub := binaryArith(limbs, BackendGenerated)
+
+			t.Run(fmt.Sprintf("%d-limbs-%d", limbs, topBit), func(t *testing.T) {
+				got := make([]uint64, limbs)
+				expected := make([]uint64, limbs)
+				for i := 0; i < differentialRepeat; i++ {
+					x, y := randLimbs(r, mod), randLimbs(r, mod)
+
+					mul(got, x, y, mod, 0)
+					mulModBinaryGeneric(expected, x, y, mod, 0)
+					checkLimbsEqual(t, "mulmod", got, expected, x, y, mod)
+
+					add(got, x, y, mod)
+					addModBinaryGeneric(expected, x, y, mod)
+					checkLimbsEqual(t, "addmod", got, expected, x, y, mod)
+
+					sub(got, x, y, mod)
+					subModBinaryGeneric(expected, x, y, mod)
+					checkLimbsEqual(t, "submod", got, expected, x, y, mod)
+				}
+			})
+		}
+	}
+}
+
+// TestKaratsubaAgainstReference checks Karatsuba multiplication against
+// schoolbook multiplication at the widths where it is used, with scratch
+// space of exactly karatsubaScratchSize limbs.
+func TestKaratsubaAgainstReference(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, limbs := range []int{karatsubaThreshold, karatsubaLimbs, 33, 47, maxLimbs, maxLimbs + 1} {
+		mod := MaxModulus(limbs)
+		scratch := make([]uint64, karatsubaScratchSize(limbs))
+		got := make([]uint64, 2*limbs)
+		expected := make([]uint64, 2*limbs)
+		for i := 0; i < 100; i++ {
+			x, y := randLimbs(r, mod), randLimbs(r, mod)
+			karatsubaMul(got, x, y, scratch)
+			schoolbookMul(expected, x, y)
+			checkLimbsEqual(t, "karatsuba", got, expected, x, y, mod)
+		}
+	}
+}
+
+func TestReferenceBackend(t *testing.T) {
+	s := rand.NewSource(42)
+	r := rand.New(s)
+	for _, size := range []int{1, 8, 31, 48, 96, 97} {
+		for _, mod := range [][]byte{new(big.Int).SetBytes(randOddModulus(size)).Bytes(), randEvenModulus(size), randBinaryModulus(size - 1)} {
+			fieldCtx, err := NewFieldContext(mod, 3, WithBackend(BackendReference))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fieldCtx.Backend() != BackendReference {
+				t.Fatalf("expected reference backend, got %s", fieldCtx.Backend())
+			}
+			modInt := new(big.Int).SetBytes(mod)
+			xInt, yInt := randBigInt(r, modInt), randBigInt(r, modInt)
+			elemSize := uint64(fieldCtx.ElemSize())
+			if err := fieldCtx.Store(0, 1, PadBytes(xInt.Bytes(), elemSize)); err != nil {
+				t.Fatal(err)
+			}
+			if err := fieldCtx.Store(1, 1, PadBytes(yInt.Bytes(), elemSize)); err != nil {
+				t.Fatal(err)
+			}
+			fieldCtx.MulMod(2, 1, 0, 1, 1, 1, 1)
+			fieldCtx.AddMod(2, 1, 2, 1, 0, 1, 1)
+
+			expected := new(big.Int).Mul(xInt, yInt)
+			expected.Add(expected, xInt)
+			expected.Mod(expected, modInt)
+			res := make([]byte, elemSize)
+			fieldCtx.Load(res, 2, 1)
+			if new(big.Int).SetBytes(res).Cmp(expected) != 0 {
+				t.Fatalf("mismatch for modulus %x: %x != %x", mod, res, expected)
+			}
+		}
+	}
+
+	if _, err := NewFieldContext([]byte{7}, 1, WithBackend(Backend(-1))); !errors.Is(err, ErrUnknownBackend) {
+		t.Fatalf("expected ErrUnknownBackend, got %v", err)
+	}
+}
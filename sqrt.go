@@ -0,0 +1,218 @@
+package evmmax_arith
+
+import (
+	"math/big"
+)
+
+// maxNonResidueCandidates bounds the search for a quadratic non-residue
+// performed by the first square root of a context.  The least non-residue of a prime is
+// small: the bound is only reached for moduli which are not prime.
+const maxNonResidueCandidates = 256
+
+// sqrtMethod identifies the square root algorithm applicable to a modulus
+type sqrtMethod int
+
+const (
+	sqrt3Mod4         sqrtMethod = iota // p = 3 mod 4: x**((p+1)/4)
+	sqrt5Mod8                           // p = 5 mod 8: Atkin's algorithm
+	sqrtTonelliShanks                   // p = 1 mod 8
+)
+
+// sqrtParams holds the values precomputed by the first square root of a
+// context to compute square roots modulo an odd prime.
+type sqrtParams struct {
+	method sqrtMethod
+	// big-endian exponent: (p+1)/4, (p-5)/8, or (q-1)/2 for Tonelli-Shanks
+	// where p - 1 = q * 2**twoAdicity
+	exp []byte
+	// Tonelli-Shanks only: the largest s such that 2**s divides p - 1, and
+	// c**q for a non-residue c, in the internal representation.
+	twoAdicity  int
+	rootOfUnity []uint64
+}
+
+// sqrtParams returns the precomputation for square roots, performing it on
+// first use.  For p = 1 mod 8 it searches for a non-residue and raises it to
+// q, which costs a full-width exponentiation: performed by NewFieldContext, it
+// would make the cost of instantiating a context (and SetModCost) depend on
+// the residue class of the modulus, whether square roots are computed or not.
+func (m *FieldContext) sqrtParams() *sqrtParams {
+	if !m.sqrtDone {
+		m.sqrt = m.newSqrtParams()
+		m.sqrtDone = true
+	}
+	return m.sqrt
+}
+
+// newSqrtParams performs the precomputation for square roots modulo the
+// (odd) modulus.  It returns nil if no non-residue was found, in which case
+// the modulus is not prime.
+func (m *FieldContext) newSqrtParams() *sqrtParams {
+	p := m.modulusInt
+	switch {
+	case p.Bit(1) == 1:
+		exp := new(big.Int).Add(p, big.NewInt(1))
+		return &sqrtParams{method: sqrt3Mod4, exp: exp.Rsh(exp, 2).Bytes()}
+	case p.Bit(2) == 1:
+		exp := new(big.Int).Sub(p, big.NewInt(5))
+		return &sqrtParams{method: sqrt5Mod8, exp: exp.Rsh(exp, 3).Bytes()}
+	}
+
+	pMinus1 := new(big.Int).Sub(p, big.NewInt(1))
+	twoAdicity := int(pMinus1.TrailingZeroBits())
+	q := new(big.Int).Rsh(pMinus1, uint(twoAdicity))
+
+	var nonResidue *big.Int
+	for c := int64(2); c < maxNonResidueCandidates; c++ {
+		if candidate := big.NewInt(c); big.Jacobi(candidate, p) == -1 {
+			nonResidue = candidate
+			break
+		}
+	}
+	if nonResidue == nil {
+		return nil
+	}
+
+	// convert the non-residue to the internal representation and raise it to q
+	elemSize := len(m.Modulus)
+	rootOfUnity := make([]uint64, elemSize)
+	c := make([]uint64, elemSize)
+	placeInt(c, nonResidue)
+	if m.useMontgomeryRepr {
+		m.mulMod(c, c, m.R2, m.Modulus, m.modInv)
+	}
+	m.exp(rootOfUnity, c, q.Bytes(), false)
+
+	exp := new(big.Int).Sub(q, big.NewInt(1))
+	return &sqrtParams{
+		method:      sqrtTonelliShanks,
+		exp:         exp.Rsh(exp, 1).Bytes(),
+		twoAdicity:  twoAdicity,
+		rootOfUnity: rootOfUnity,
+	}
+}
+
+// Sqrt sets the element at offset 'out' to a square root of the element at
+// offset 'x' modulo a prime.  It returns ErrNoSquareRoot if x is not a
+// quadratic residue (or no root was found because the modulus is not prime),
+// and ErrUnsupportedModulus for even moduli.  The scratch space is not
+// modified if an error is returned.
+//
+// The first call performs the precomputation for the modulus, which for
+// p = 1 mod 8 costs about as much as an exponentiation.
+//
+// Square roots are computed without data-dependent branching.  it is not
+// validated that inputs are within bounds: use SqrtChecked for untrusted
+// inputs.
+func (m *FieldContext) Sqrt(out, x uint) error {
+	if m.modulusInt.Bit(0) == 0 {
+		return ErrUnsupportedModulus
+	}
+	if m.sqrtParams() == nil {
+		return ErrNoSquareRoot
+	}
+	elemSize := uint(len(m.Modulus))
+	xVal := m.scratchSpace[x*elemSize : (x+1)*elemSize]
+	res := make([]uint64, elemSize)
+
+	switch m.sqrt.method {
+	case sqrt3Mod4:
+		m.exp(res, xVal, m.sqrt.exp, true)
+	case sqrt5Mod8:
+		m.sqrtAtkin(res, xVal)
+	case sqrtTonelliShanks:
+		m.sqrtTonelliShanks(res, xVal)
+	}
+
+	// the candidate is only a root if x is a quadratic residue
+	square := make([]uint64, elemSize)
+	m.mulMod(square, res, res, m.Modulus, m.modInv)
+	if !eq(square, xVal) {
+		return ErrNoSquareRoot
+	}
+	copy(m.scratchSpace[out*elemSize:(out+1)*elemSize], res)
+	return nil
+}
+
+// sqrtAtkin computes a square root candidate modulo p = 5 mod 8:
+// t = (2x)**((p-5)/8), i = 2x * t**2, root = x * t * (i - 1)
+func (m *FieldContext) sqrtAtkin(out, x []uint64) {
+	elemSize := len(m.Modulus)
+	x2 := make([]uint64, elemSize)
+	t := make([]uint64, elemSize)
+	i := make([]uint64, elemSize)
+
+	m.addMod(x2, x, x, m.Modulus)
+	m.exp(t, x2, m.sqrt.exp, true)
+	m.mulMod(i, t, t, m.Modulus, m.modInv)
+	m.mulMod(i, i, x2, m.Modulus, m.modInv)
+	m.subMod(i, i, m.oneRepr, m.Modulus)
+	m.mulMod(out, x, t, m.Modulus, m.modInv)
+	m.mulMod(out, out, i, m.Modulus, m.modInv)
+}
+
+// sqrtTonelliShanks computes a square root candidate with the constant-time
+// Tonelli-Shanks variant of RFC 9380, appendix I.4.
+func (m *FieldContext) sqrtTonelliShanks(out, x []uint64) {
+	elemSize := len(m.Modulus)
+	z := make([]uint64, elemSize)
+	t := make([]uint64, elemSize)
+	b := make([]uint64, elemSize)
+	c := make([]uint64, elemSize)
+	tmp := make([]uint64, elemSize)
+
+	// z = x**((q-1)/2), t = x**q, z = x**((q+1)/2)
+	m.exp(z, x, m.sqrt.exp, true)
+	m.mulMod(t, z, z, m.Modulus, m.modInv)
+	m.mulMod(t, t, x, m.Modulus, m.modInv)
+	m.mulMod(z, z, x, m.Modulus, m.modInv)
+	copy(b, t)
+	copy(c, m.sqrt.rootOfUnity)
+
+	for i := m.sqrt.twoAdicity; i >= 2; i-- {
+		for j := 1; j <= i-2; j++ {
+			m.mulMod(b, b, b, m.Modulus, m.modInv)
+		}
+		// keep z and t if b**(2**(i-2)) == 1
+		isOne := ctEqLimbsMask(b, m.oneRepr)
+		m.mulMod(tmp, z, c, m.Modulus, m.modInv)
+		ctSelect(z, z, tmp, isOne)
+		m.mulMod(c, c, c, m.Modulus, m.modInv)
+		m.mulMod(tmp, t, c, m.Modulus, m.modInv)
+		ctSelect(t, t, tmp, isOne)
+		copy(b, t)
+	}
+	copy(out, z)
+}
+
+// Jacobi returns the Jacobi symbol (x/p) of the element at offset 'x' for an
+// odd modulus p: the Legendre symbol if p is prime.  It returns
+// ErrUnsupportedModulus for even moduli.
+//
+// Execution time depends on the value of x.  it is not validated that inputs
+// are within bounds: use JacobiChecked for untrusted inputs.
+func (m *FieldContext) Jacobi(x uint) (int, error) {
+	if m.modulusInt.Bit(0) == 0 {
+		return 0, ErrUnsupportedModulus
+	}
+	return big.Jacobi(new(big.Int).SetBytes(m.canonicalBytes(x)), m.modulusInt), nil
+}
+
+// eq returns true if x == y.  x and y must have the same number of limbs.
+func eq(x, y []uint64) bool {
+	return ctEqLimbsMask(x, y) != 0
+}
+
+// ctEqLimbsMask returns all ones if x == y and zero otherwise, without branching.
+func ctEqLimbsMask(x, y []uint64) uint64 {
+	var d uint64
+	for i := range x {
+		d |= x[i] ^ y[i]
+	}
+	return ctEqMask(d, 0)
+}
+
+// placeInt places a non-negative value into little-endian limbs.
+func placeInt(out []uint64, val *big.Int) {
+	copy(out, bytesToLimbs(PadBytes(val.Bytes(), uint64(len(out)*8))))
+}
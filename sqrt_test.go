@@ -0,0 +1,127 @@
+package evmmax_arith
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func mustHex(s string) *big.Int {
+	res, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("invalid hex string")
+	}
+	return res
+}
+
+var sqrtTestPrimes = map[string]*big.Int{
+	// p = 3 mod 4
+	"bn254-fp":     mustHex("30644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd47"),
+	"secp256k1-fp": mustHex("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"),
+	"bls12381-fp":  mustHex("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab"),
+	// p = 5 mod 8
+	"25519": mustHex("7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed"),
+	"13":    big.NewInt(13),
+	// p = 1 mod 8
+	"97":           big.NewInt(97),
+	"goldilocks":   mustHex("ffffffff00000001"),
+	"bn254-fr":     mustHex("30644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000001"),
+	"bls12381-fr":  mustHex("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001"),
+	"secp256k1-fr": mustHex("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"),
+}
+
+func TestSqrt(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for name, p := range sqrtTestPrimes {
+		fieldCtx, err := NewFieldContext(p.Bytes(), 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vals := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(4), new(big.Int).Sub(p, big.NewInt(1))}
+		for i := 0; i < 20; i++ {
+			vals = append(vals, randBigInt(r, p))
+		}
+		for _, val := range vals {
+			storeInt(t, fieldCtx, 0, val)
+
+			expectedSymbol := big.Jacobi(val, p)
+			symbol, err := fieldCtx.Jacobi(0)
+			if err != nil || symbol != expectedSymbol {
+				t.Fatalf("%s: jacobi(%s): received %d (%v) != expected %d", name, val, symbol, err, expectedSymbol)
+			}
+
+			err = fieldCtx.Sqrt(1, 0)
+			if expectedSymbol == -1 {
+				if !errors.Is(err, ErrNoSquareRoot) {
+					t.Fatalf("%s: sqrt(%s): expected error, got %v", name, val, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%s: sqrt(%s): unexpected error: %v", name, val, err)
+			}
+			root := loadInt(fieldCtx, 1)
+			if square := new(big.Int).Mul(root, root); square.Mod(square, p).Cmp(val) != 0 {
+				t.Fatalf("%s: sqrt(%s): %s is not a square root", name, val, root)
+			}
+		}
+	}
+}
+
+func TestSqrtUnsupported(t *testing.T) {
+	binaryCtx, err := NewFieldContext(randBinaryModulus(8), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := binaryCtx.Sqrt(0, 0); !errors.Is(err, ErrUnsupportedModulus) {
+		t.Fatalf("expected unsupported modulus error, got %v", err)
+	}
+	if _, err := binaryCtx.Jacobi(0); !errors.Is(err, ErrUnsupportedModulus) {
+		t.Fatalf("expected unsupported modulus error, got %v", err)
+	}
+
+	evenCtx, err := NewFieldContext(big.NewInt(6).Bytes(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := evenCtx.Sqrt(0, 0); !errors.Is(err, ErrUnsupportedModulus) {
+		t.Fatalf("expected unsupported modulus error, got %v", err)
+	}
+
+	// 17**2 = 1 mod 8 is a square: every jacobi symbol is non-negative
+	squareCtx, err := NewFieldContext(big.NewInt(17*17).Bytes(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storeInt(t, squareCtx, 0, big.NewInt(4))
+	if err := squareCtx.Sqrt(0, 0); !errors.Is(err, ErrNoSquareRoot) {
+		t.Fatalf("expected no square root error for composite modulus, got %v", err)
+	}
+}
+
+// TestSqrtParamsLazy checks that the square root precomputation, which is
+// costly for p = 1 mod 8, is deferred from context creation to the first
+// square root.
+func TestSqrtParamsLazy(t *testing.T) {
+	p := sqrtTestPrimes["bn254-fr"]
+	fieldCtx, err := NewFieldContext(p.Bytes(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fieldCtx.sqrtDone || fieldCtx.sqrt != nil {
+		t.Fatal("square root parameters computed by NewFieldContext")
+	}
+	storeInt(t, fieldCtx, 0, big.NewInt(4))
+	if err := fieldCtx.Sqrt(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !fieldCtx.sqrtDone || fieldCtx.sqrt == nil || fieldCtx.sqrt.method != sqrtTonelliShanks {
+		t.Fatalf("unexpected square root parameters %+v", fieldCtx.sqrt)
+	}
+	root := loadInt(fieldCtx, 1)
+	if square := new(big.Int).Mul(root, root); square.Mod(square, p).Cmp(big.NewInt(4)) != 0 {
+		t.Fatalf("%s is not a square root of 4", root)
+	}
+}
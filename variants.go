@@ -0,0 +1,92 @@
+package evmmax_arith
+
+// montVariant identifies a formulation of Montgomery multiplication emitted by
+// the generator.
+type montVariant int
+
+const (
+	montCIOS    montVariant = iota // coarsely integrated operand scanning
+	montFIOS                       // finely integrated operand scanning
+	montSOS                        // separated operand scanning
+	montNoCarry                    // CIOS without carry propagation out of the top limb
+	montADX                        // CIOS in amd64 assembly using MULX/ADCX/ADOX
+)
+
+// montVariants lists every variant
+var montVariants = []montVariant{montCIOS, montFIOS, montSOS, montNoCarry, montADX}
+
+func (v montVariant) String() string {
+	switch v {
+	case montCIOS:
+		return "cios"
+	case montFIOS:
+		return "fios"
+	case montSOS:
+		return "sos"
+	case montNoCarry:
+		return "nocarry"
+	case montADX:
+		return "adx"
+	default:
+		return "unknown"
+	}
+}
+
+// mulFunc returns the implementation of the variant for the given limb count,
+// or nil if it is not available on this platform.
+func (v montVariant) mulFunc(limbs int) mulFunc {
+	switch v {
+	case montCIOS:
+		return mulmodPreset[limbs-1]
+	case montFIOS:
+		return mulmodFIOSPreset[limbs-1]
+	case montSOS:
+		return mulmodSOSPreset[limbs-1]
+	case montNoCarry:
+		return mulmodNoCarryPreset[limbs-1]
+	case montADX:
+		return mulMontAsm(limbs)
+	default:
+		return nil
+	}
+}
+
+// applicable returns whether the variant computes correct results for mod
+func (v montVariant) applicable(mod []uint64) bool {
+	if v == montNoCarry {
+		// the carries out of the top limb can only be dropped if the top
+		// limb leaves a spare bit
+		return mod[len(mod)-1] < 1<<63-1
+	}
+	return true
+}
+
+// montVariantRanking lists the variants for each limb count from the fastest
+// to the slowest, as measured by the montmul-* cases of BenchmarkOps on amd64.
+// The 12 limb no-carry and SOS variants are disproportionately slow as the
+// compiler spills their intermediate values.
+var montVariantRanking = [][]montVariant{
+	{montNoCarry, montSOS, montCIOS, montADX, montFIOS},
+	{montNoCarry, montADX, montSOS, montCIOS, montFIOS},
+	{montADX, montNoCarry, montSOS, montCIOS, montFIOS},
+	{montADX, montNoCarry, montCIOS, montSOS, montFIOS},
+	{montADX, montCIOS, montNoCarry, montSOS, montFIOS},
+	{montADX, montNoCarry, montCIOS, montSOS, montFIOS},
+	{montNoCarry, montCIOS, montFIOS, montSOS},
+	{montNoCarry, montCIOS, montSOS, montFIOS},
+	{montNoCarry, montCIOS, montFIOS, montSOS},
+	{montNoCarry, montCIOS, montFIOS, montSOS},
+	{montNoCarry, montFIOS, montCIOS, montSOS},
+	{montCIOS, montFIOS, montSOS, montNoCarry},
+}
+
+// selectMontVariant returns the fastest generated Montgomery multiplication
+// variant which is available and applicable for mod.
+func selectMontVariant(mod []uint64) montVariant {
+	for _, v := range montVariantRanking[len(mod)-1] {
+		if v.mulFunc(len(mod)) != nil && v.applicable(mod) {
+			return v
+		}
+	}
+	return montCIOS
+}
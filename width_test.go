@@ -0,0 +1,267 @@
+package evmmax_arith
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// The helpers below are called by the generated per-width tests and
+// benchmarks (generated_arith_test.go): checkGenerated<Kind> and
+// benchmarkGenerated<Kind> for each preset kind of the generator.
+
+// generatedRandomOperands is the number of random operands tested in addition
+// to the edge values, each against every other operand
+const generatedRandomOperands = 8
+
+// binaryTestModuli returns power of two moduli of the given limb count
+func binaryTestModuli(limbs int) [][]uint64 {
+	var moduli [][]uint64
+	for _, topBit := range []uint{0, 31, 63} {
+		mod := make([]uint64, limbs)
+		mod[limbs-1] = 1 << topBit
+		moduli = append(moduli, mod)
+	}
+	return moduli
+}
+
+// spareBitModulus returns the largest odd modulus of the given limb count with
+// two spare bits in the top limb
+func spareBitModulus(limbs int) []uint64 {
+	mod := MaxModulus(limbs)
+	mod[limbs-1] >>= 2
+	return mod
+}
+
+// testOperands returns the edge values and random operands reduced by mod
+func testOperands(r *rand.Rand, mod []uint64) [][]uint64 {
+	operands := edgeValues(mod)
+	for i := 0; i < generatedRandomOperands; i++ {
+		operands = append(operands, randLimbs(r, mod))
+	}
+	return operands
+}
+
+// checkMul checks mul against the reference implementation ref for each
+// modulus and every pair of test operands, including with the output aliasing
+// the first operand.
+func checkMul(t *testing.T, mul, ref mulFunc, moduli [][]uint64) {
+	t.Helper()
+	r := rand.New(rand.NewSource(42))
+	for _, mod := range moduli {
+		modInv := negModInverse(mod[0])
+		got := make([]uint64, len(mod))
+		expected := make([]uint64, len(mod))
+		operands := testOperands(r, mod)
+		for _, x := range operands {
+			for _, y := range operands {
+				mul(got, x, y, mod, modInv)
+				ref(expected, x, y, mod, modInv)
+				checkLimbsEqual(t, t.Name(), got, expected, x, y, mod)
+
+				copy(got, x)
+				mul(got, got, y, mod, modInv)
+				checkLimbsEqual(t, t.Name()+" aliased", got, expected, x, y, mod)
+			}
+		}
+	}
+}
+
+// checkAddOrSub checks op against the reference implementation ref like
+// checkMul.
+func checkAddOrSub(t *testing.T, op, ref addOrSubFunc, moduli [][]uint64) {
+	t.Helper()
+	r := rand.New(rand.NewSource(42))
+	for _, mod := range moduli {
+		got := make([]uint64, len(mod))
+		expected := make([]uint64, len(mod))
+		operands := testOperands(r, mod)
+		for _, x := range operands {
+			for _, y := range operands {
+				op(got, x, y, mod)
+				ref(expected, x, y, mod)
+				checkLimbsEqual(t, t.Name(), got, expected, x, y, mod)
+
+				copy(got, x)
+				op(got, got, y, mod)
+				checkLimbsEqual(t, t.Name()+" aliased", got, expected, x, y, mod)
+			}
+		}
+	}
+}
+
+func checkGeneratedMontMul(t *testing.T, mul mulFunc, limbs int) {
+	checkMul(t, mul, montMulGeneric, differentialModuli(rand.New(rand.NewSource(42)), limbs))
+}
+
+// checkGeneratedMontMulNoCarry checks the no-carry variant on the moduli it
+// applies to.
+func checkGeneratedMontMulNoCarry(t *testing.T, mul mulFunc, limbs int) {
+	var moduli [][]uint64
+	for _, mod := range differentialModuli(rand.New(rand.NewSource(42)), limbs) {
+		if montNoCarry.applicable(mod) {
+			moduli = append(moduli, mod)
+		}
+	}
+	checkMul(t, mul, montMulGeneric, append(moduli, spareBitModulus(limbs)))
+}
+
+func checkGeneratedMontSqr(t *testing.T, sqr sqrFunc, limbs int) {
+	mul := func(out, x, y, mod []uint64, modInv uint64) {
+		sqr(out, x, mod, modInv)
+	}
+	square := func(out, x, y, mod []uint64, modInv uint64) {
+		montMulGeneric(out, x, x, mod, modInv)
+	}
+	checkMul(t, mul, square, differentialModuli(rand.New(rand.NewSource(42)), limbs))
+}
+
+func checkGeneratedAddMod(t *testing.T, add addOrSubFunc, limbs int) {
+	checkAddOrSub(t, add, addModGeneric, differentialModuli(rand.New(rand.NewSource(42)), limbs))
+}
+
+func checkGeneratedSubMod(t *testing.T, sub addOrSubFunc, limbs int) {
+	checkAddOrSub(t, sub, subModGeneric, differentialModuli(rand.New(rand.NewSource(42)), limbs))
+}
+
+func checkGeneratedMulModBinary(t *testing.T, mul mulFunc, limbs int) {
+	checkMul(t, mul, mulModBinaryGeneric, binaryTestModuli(limbs))
+}
+
+func checkGeneratedAddModBinary(t *testing.T, add addOrSubFunc, limbs int) {
+	checkAddOrSub(t, add, addModBinaryGeneric, binaryTestModuli(limbs))
+}
+
+func checkGeneratedSubModBinary(t *testing.T, sub addOrSubFunc, limbs int) {
+	checkAddOrSub(t, sub, subModBinaryGeneric, binaryTestModuli(limbs))
+}
+
+// pseudoMersenneTestModuli returns moduli 2**k - c of the given limb count
+// which use pseudo-Mersenne arithmetic, with bit k at various positions of the
+// top limb and various c.
+func pseudoMersenneTestModuli(limbs int) []*big.Int {
+	var moduli []*big.Int
+	for _, shift := range []int{64, 63, 32, 2} {
+		for _, c := range []uint64{1, 19, 1<<32 + 977, 1<<32 - 1, 1<<40 - 87} {
+			mod := new(big.Int).Lsh(big.NewInt(1), uint(64*(limbs-1)+shift))
+			mod.Sub(mod, new(big.Int).SetUint64(c))
+			if mod.Sign() > 0 && detectPseudoMersenne(mod) != nil {
+				moduli = append(moduli, mod)
+			}
+		}
+	}
+	return moduli
+}
+
+// mulModBigInt computes out = x * y % mod with math/big
+func mulModBigInt(out, x, y, mod []uint64, _ uint64) {
+	res := new(big.Int).Mul(limbsToInt(x), limbsToInt(y))
+	placeInt(out, res.Mod(res, limbsToInt(mod)))
+}
+
+func checkGeneratedMulModPseudoMersenne(t *testing.T, mul pseudoMersenneMulFunc, limbs int) {
+	moduli := pseudoMersenneTestModuli(limbs)
+	if len(moduli) == 0 {
+		t.Fatalf("no pseudo-Mersenne test moduli of %d limbs", limbs)
+	}
+	for _, modInt := range moduli {
+		pm := detectPseudoMersenne(modInt)
+		mod := bytesToLimbs(PadBytes(modInt.Bytes(), uint64(limbs*8)))
+		checkMul(t, func(out, x, y, mod []uint64, _ uint64) {
+			mul(out, x, y, mod, pm.c, pm.shift, pm.folds)
+		}, mulModBigInt, [][]uint64{mod})
+	}
+}
+
+// barrettTestModuli returns odd and even moduli of the given limb count with
+// a non-zero top limb.
+func barrettTestModuli(limbs int) [][]uint64 {
+	r := rand.New(rand.NewSource(42))
+	even := bytesToLimbs(randEvenModulus(limbs * 8))
+	even[limbs-1] |= 1 << 63
+	return append(differentialModuli(r, limbs), even)
+}
+
+func checkGeneratedMulModBarrett(t *testing.T, mul barrettMulFunc, limbs int) {
+	for _, mod := range barrettTestModuli(limbs) {
+		mu := newBarrett(limbsToInt(mod), limbs).mu
+		checkMul(t, func(out, x, y, mod []uint64, _ uint64) {
+			mul(out, x, y, mod, mu)
+		}, mulModBigInt, [][]uint64{mod})
+	}
+}
+
+// benchmarkAddOrSub benchmarks an addition or subtraction implementation in
+// isolation on operands reduced by mod.
+func benchmarkAddOrSub(b *testing.B, op addOrSubFunc, mod []uint64) {
+	r := rand.New(rand.NewSource(42))
+	x, y := randLimbs(r, mod), randLimbs(r, mod)
+	out := make([]uint64, len(mod))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		op(out, x, y, mod)
+	}
+}
+
+func benchmarkGeneratedMontMul(b *testing.B, mul mulFunc, limbs int) {
+	benchmarkMontMul(b, mul, MaxModulus(limbs))
+}
+
+func benchmarkGeneratedMontMulNoCarry(b *testing.B, mul mulFunc, limbs int) {
+	benchmarkMontMul(b, mul, spareBitModulus(limbs))
+}
+
+func benchmarkGeneratedMontSqr(b *testing.B, sqr sqrFunc, limbs int) {
+	mod := MaxModulus(limbs)
+	modInv := negModInverse(mod[0])
+	x := randLimbs(rand.New(rand.NewSource(42)), mod)
+	out := make([]uint64, limbs)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sqr(out, x, mod, modInv)
+	}
+}
+
+func benchmarkGeneratedAddMod(b *testing.B, add addOrSubFunc, limbs int) {
+	benchmarkAddOrSub(b, add, MaxModulus(limbs))
+}
+
+func benchmarkGeneratedSubMod(b *testing.B, sub addOrSubFunc, limbs int) {
+	benchmarkAddOrSub(b, sub, MaxModulus(limbs))
+}
+
+func benchmarkGeneratedMulModBinary(b *testing.B, mul mulFunc, limbs int) {
+	mod := make([]uint64, limbs)
+	mod[limbs-1] = 1 << 63
+	benchmarkMontMul(b, mul, mod)
+}
+
+func benchmarkGeneratedAddModBinary(b *testing.B, add addOrSubFunc, limbs int) {
+	mod := make([]uint64, limbs)
+	mod[limbs-1] = 1 << 63
+	benchmarkAddOrSub(b, add, mod)
+}
+
+func benchmarkGeneratedSubModBinary(b *testing.B, sub addOrSubFunc, limbs int) {
+	mod := make([]uint64, limbs)
+	mod[limbs-1] = 1 << 63
+	benchmarkAddOrSub(b, sub, mod)
+}
+
+func benchmarkGeneratedMulModPseudoMersenne(b *testing.B, mul pseudoMersenneMulFunc, limbs int) {
+	// the largest modulus 2**k - c with secp256k1's c
+	modInt := new(big.Int).Lsh(big.NewInt(1), uint(64*limbs))
+	modInt.Sub(modInt, big.NewInt(1<<32+977))
+	pm := detectPseudoMersenne(modInt)
+	benchmarkMontMul(b, func(out, x, y, mod []uint64, _ uint64) {
+		mul(out, x, y, mod, pm.c, pm.shift, pm.folds)
+	}, bytesToLimbs(modInt.Bytes()))
+}
+
+func benchmarkGeneratedMulModBarrett(b *testing.B, mul barrettMulFunc, limbs int) {
+	mod := barrettTestModuli(limbs)[0]
+	mu := newBarrett(limbsToInt(mod), limbs).mu
+	benchmarkMontMul(b, func(out, x, y, mod []uint64, _ uint64) {
+		mul(out, x, y, mod, mu)
+	}, mod)
+}